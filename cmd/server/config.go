@@ -0,0 +1,97 @@
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/config"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/cache"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/queue"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// registerResultCacheMetrics declares the metrics the usecase's in-process cache reports.
+func registerResultCacheMetrics(app *gofr.App) {
+	m := app.Metrics()
+	m.NewCounter(usecase.MetricResultCacheHits, "Validation requests served from the in-process cache")
+	m.NewCounter(usecase.MetricResultCacheMisses, "Validation requests not found in the in-process cache")
+	m.NewCounter(usecase.MetricResultCacheCoalesced, "Validation requests that waited for a concurrent identical request instead of validating")
+	m.NewCounter(usecase.MetricResultCacheEvictions, "Entries evicted from the in-process cache to stay within RESULT_CACHE_SIZE")
+	m.NewGauge(usecase.MetricResultCacheHitRatio, "Fraction of validation requests served from the in-process cache")
+}
+
+// newAddressRepository wraps the parser in the result cache selected by CACHE_BACKEND.
+func newAddressRepository(app *gofr.App, parser *address_parser.GopostalParser, checks *healthChecks) usecase.ValidateAddressRepository {
+	cacheConfig := cache.Config{
+		TTL:         config.Duration(app, "CACHE_TTL", "24h"),
+		NegativeTTL: config.Duration(app, "CACHE_NEGATIVE_TTL", "5m"),
+	}
+
+	switch backend := app.Config.GetOrDefault("CACHE_BACKEND", "none"); backend {
+	case "none":
+		return parser
+	case "memory":
+		return cache.NewRepository(parser, cache.NewMemoryStore(), cacheConfig)
+	case "redis":
+		store := config.RedisStore(app, "CACHE_BACKEND=redis")
+		checks.add("cache", store.Ping)
+		return cache.NewRepository(parser, store, cacheConfig)
+	default:
+		app.Logger().Fatalf("invalid CACHE_BACKEND %q: use none, memory or redis", backend)
+		return nil
+	}
+}
+
+// newValidationPipeline returns the usecase handlers validate through, selected by VALIDATION_PIPELINE.
+// The async pipeline publishes requests for a worker: in-process with ASYNC_BROKER=memory,
+// or cmd/worker over GoFr pub/sub with ASYNC_BROKER=pubsub.
+func newValidationPipeline(
+	app *gofr.App,
+	validator *usecase.ValidateAddressUsecase,
+	strictness entity.Strictness,
+	checks *healthChecks,
+) usecase.ValidateAddressUsecaseInterface {
+	switch pipeline := app.Config.GetOrDefault("VALIDATION_PIPELINE", "sync"); pipeline {
+	case "sync":
+		return validator
+	case "async":
+	default:
+		app.Logger().Fatalf("invalid VALIDATION_PIPELINE %q: use sync or async", pipeline)
+	}
+
+	asyncConfig := usecase.AsyncValidateAddressConfig{
+		DefaultStrictness: strictness,
+		WaitTimeout:       config.Duration(app, "ASYNC_WAIT_TIMEOUT", "5s"),
+	}
+	resultTTL := config.Duration(app, "ASYNC_RESULT_TTL", "10m")
+
+	switch broker := app.Config.GetOrDefault("ASYNC_BROKER", "memory"); broker {
+	case "memory":
+		results := cache.NewResultStore(cache.NewMemoryStore(), resultTTL)
+		jobs := queue.NewMemoryQueue(config.Int(app, "ASYNC_QUEUE_SIZE", "1000"), queue.MemoryQueueConfig{})
+		jobs.Start(config.Int(app, "ASYNC_WORKERS", "4"), usecase.NewProcessValidationJobUsecase(validator, results).Execute)
+
+		return usecase.NewAsyncValidateAddressUsecase(jobs, results, asyncConfig)
+	case "pubsub":
+		if app.Config.Get("PUBSUB_BACKEND") == "" {
+			app.Logger().Fatalf("ASYNC_BROKER=pubsub requires PUBSUB_BACKEND")
+		}
+
+		jobs := queue.NewPubSubQueue(app.Config.GetOrDefault("ASYNC_TOPIC", "address-validation"))
+		app.OnStart(func(ctx *gofr.Context) error {
+			jobs.SetPublisher(ctx.GetPublisher())
+			return nil
+		})
+		checks.add("broker", publisherCheck(jobs))
+
+		store := config.RedisStore(app, "ASYNC_BROKER=pubsub")
+		checks.add("result_store", store.Ping)
+		results := cache.NewResultStore(store, resultTTL)
+
+		return usecase.NewAsyncValidateAddressUsecase(jobs, results, asyncConfig)
+	default:
+		app.Logger().Fatalf("invalid ASYNC_BROKER %q: use memory or pubsub", broker)
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+
+	"github.com/williandandrade/address-validation-service/internal/config"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/queue"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// healthChecks collects a readiness check for each dependency main configures.
+type healthChecks []usecase.HealthCheck
+
+func (c *healthChecks) add(name string, check func(ctx context.Context) error) {
+	*c = append(*c, usecase.HealthCheck{Name: name, Check: check})
+}
+
+// newHealthUsecase warms the parser up in the background and reports readiness once
+// it, the reference data and every check in checks are up.
+func newHealthUsecase(
+	app *gofr.App,
+	parser usecase.ValidateAddressRepository,
+	localities *reference.LocalityStore,
+	checks healthChecks,
+) *usecase.HealthUsecase {
+	warmup := usecase.NewParserWarmup(parser, usecase.ParserWarmupConfig{
+		OnFailure: func(err error, retryIn time.Duration) {
+			app.Logger().Errorf("parser warm-up failed, retrying in %s: %v", retryIn, err)
+		},
+	})
+	// Without a deadline Run retries until the parser is warm, logging each failure.
+	go warmup.Run(context.Background())
+
+	all := healthChecks{}
+	all.add("parser", warmup.Check)
+	all.add("reference_data", func(context.Context) error {
+		if localities.Len() == 0 {
+			return errors.New("no reference localities loaded")
+		}
+		return nil
+	})
+	all = append(all, checks...)
+
+	return usecase.NewHealthUsecase(usecase.HealthConfig{
+		AppVersion: app.Config.GetOrDefault("APP_VERSION", "dev"),
+		Versions: map[string]string{
+			"parser":         address_parser.ParserVersion,
+			"reference_data": reference.DatasetVersion,
+			"canonical_key":  entity.CanonicalKeyVersion,
+		},
+		Checks:       all,
+		CheckTimeout: config.Duration(app, "HEALTH_CHECK_TIMEOUT", "2s"),
+	})
+}
+
+// publisherCheck reports the broker down until GoFr's pub/sub client is bound and,
+// for clients that report their connection health, connected.
+func publisherCheck(jobs *queue.PubSubQueue) func(ctx context.Context) error {
+	return func(context.Context) error {
+		publisher := jobs.Publisher()
+		if publisher == nil {
+			return queue.ErrNotReady
+		}
+
+		reporter, ok := publisher.(interface{ Health() datasource.Health })
+		if !ok {
+			return nil
+		}
+		if health := reporter.Health(); health.Status != datasource.StatusUp {
+			return fmt.Errorf("broker is %s", strings.ToLower(health.Status))
+		}
+		return nil
+	}
+}
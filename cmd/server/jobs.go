@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/config"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/jobstore"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/webhook"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+	"github.com/williandandrade/address-validation-service/migrations"
+)
+
+// newJobsUsecase sets up batch jobs on GoFr's SQL datasource and starts JOB_WORKERS
+// background runners, plus a webhook deliverer, once the app starts. It returns false
+// when no database is configured, in which case the jobs API is not served.
+func newJobsUsecase(
+	app *gofr.App,
+	validator usecase.ValidateAddressUsecaseInterface,
+	strictness entity.Strictness,
+	checks *healthChecks,
+) (*usecase.JobsUsecase, *usecase.WebhooksUsecase, bool) {
+	if app.Config.Get("DB_DIALECT") == "" {
+		app.Logger().Infof("jobs API disabled: set DB_DIALECT (e.g. sqlite) to enable it")
+		return nil, nil, false
+	}
+
+	app.Migrate(migrations.All())
+
+	secrets := webhookSecrets(app)
+	store := jobstore.NewStore()
+	checks.add("database", store.Ping)
+	runner := usecase.NewRunJobsUsecase(store, validator, usecase.NewWebhookNotifier(), usecase.RunJobsConfig{
+		MaxAttempts: config.Int(app, "JOB_MAX_ATTEMPTS", "5"),
+		BaseBackoff: config.Duration(app, "JOB_BACKOFF", "10s"),
+		MaxBackoff:  config.Duration(app, "JOB_MAX_BACKOFF", "10m"),
+	})
+	workers := config.Int(app, "JOB_WORKERS", "2")
+	interval := config.Duration(app, "JOB_POLL_INTERVAL", "1s")
+
+	deliverer := usecase.NewDeliverWebhooksUsecase(store,
+		webhook.NewHTTPSender(config.Duration(app, "WEBHOOK_TIMEOUT", "10s")),
+		usecase.WebhookConfig{
+			Secrets:     secrets,
+			MaxAttempts: config.Int(app, "WEBHOOK_MAX_ATTEMPTS", "8"),
+			BaseBackoff: config.Duration(app, "WEBHOOK_BACKOFF", "5s"),
+			MaxBackoff:  config.Duration(app, "WEBHOOK_MAX_BACKOFF", "1h"),
+			Lease:       config.Duration(app, "WEBHOOK_LEASE", "10m"),
+		})
+	deliveryInterval := config.Duration(app, "WEBHOOK_POLL_INTERVAL", "1s")
+
+	app.OnStart(func(ctx *gofr.Context) error {
+		store.SetDB(ctx.SQL, func() (jobstore.Tx, error) {
+			tx, err := ctx.SQL.Begin()
+			if err != nil {
+				return nil, err
+			}
+			return tx, nil
+		})
+
+		n, err := store.RequeueRunningJobs(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			app.Logger().Infof("requeued %d jobs interrupted by a restart", n)
+		}
+
+		for range workers {
+			go runJobs(app, runner, interval)
+		}
+		go deliverWebhooks(app, deliverer, deliveryInterval)
+		return nil
+	})
+
+	jobs := usecase.NewJobsUsecase(store, usecase.JobsConfig{
+		DefaultStrictness: strictness,
+		WebhookSecrets:    secrets,
+	})
+	return jobs, usecase.NewWebhooksUsecase(store, store), true
+}
+
+// runJobs processes queued jobs back to back, polling every interval while the queue is
+// empty. It also waits an interval after an error, so a requeued job is not retried in a
+// tight loop.
+func runJobs(app *gofr.App, runner *usecase.RunJobsUsecase, interval time.Duration) {
+	for {
+		claimed, err := runner.Execute(context.Background())
+		if err != nil {
+			app.Logger().Errorf("batch job failed: %v", err)
+		}
+		if !claimed || err != nil {
+			time.Sleep(interval)
+		}
+	}
+}
+
+// deliverWebhooks sends due webhooks back to back, polling every interval once none are due.
+func deliverWebhooks(app *gofr.App, deliverer *usecase.DeliverWebhooksUsecase, interval time.Duration) {
+	for {
+		n, err := deliverer.Execute(context.Background())
+		if err != nil {
+			app.Logger().Errorf("webhook delivery failed: %v", err)
+		}
+		if n == 0 {
+			time.Sleep(interval)
+		}
+	}
+}
+
+// webhookSecrets parses WEBHOOK_SECRETS, a comma-separated list of tenant:secret pairs.
+// Tenants without a secret cannot ask for callbacks.
+func webhookSecrets(app *gofr.App) map[string]string {
+	secrets := make(map[string]string)
+	for _, pair := range strings.Split(app.Config.Get("WEBHOOK_SECRETS"), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		tenant, secret, ok := strings.Cut(pair, ":")
+		if !ok || tenant == "" || secret == "" {
+			app.Logger().Fatalf("invalid WEBHOOK_SECRETS: entries must be tenant:secret pairs")
+		}
+		secrets[tenant] = secret
+	}
+	return secrets
+}
@@ -4,7 +4,10 @@ import (
 	"gofr.dev/pkg/gofr"
 
 	"github.com/williandandrade/address-validation-service/internal/api/handler"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	"github.com/williandandrade/address-validation-service/internal/config"
 	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
 	"github.com/williandandrade/address-validation-service/internal/usecase"
 )
 
@@ -14,16 +17,90 @@ const (
 
 func main() {
 	app := gofr.New()
+	app.UseMiddleware(middleware.Tenant(config.Prefixes(app, "TRUSTED_PROXIES")))
+	app.UseMiddleware(middleware.Accept)
+	app.UseMiddleware(middleware.AcceptLanguage)
 
 	// Infrastructure
+	var checks healthChecks
 	parser := address_parser.NewGopostalParser()
+	spanFinder := address_parser.NewSpanFinder()
+	repo := newAddressRepository(app, parser, &checks)
+
+	localities, err := reference.NewLocalityStore()
+	if err != nil {
+		app.Logger().Fatalf("failed to load reference data: %v", err)
+	}
+
+	autocompleteIndex, err := reference.NewAutocompleteIndex(localities)
+	if err != nil {
+		app.Logger().Fatalf("failed to build autocomplete index: %v", err)
+	}
+
+	strictness := config.Strictness(app)
+
+	registerResultCacheMetrics(app)
 
 	// Usecases
-	validateAddressUsecase := usecase.NewValidateAddressUsecase(parser)
+	validateAddressUsecase := usecase.NewValidateAddressUsecase(repo, localities, usecase.ValidateAddressConfig{
+		DefaultStrictness: strictness,
+		CacheSize:         config.Int(app, "RESULT_CACHE_SIZE", "10000"),
+		CacheTTL:          config.Duration(app, "RESULT_CACHE_TTL", "10m"),
+		Metrics:           app.Metrics(),
+	})
+	validationPipeline := newValidationPipeline(app, validateAddressUsecase, strictness, &checks)
+	extractAddressesUsecase := usecase.NewExtractAddressesUsecase(spanFinder, validationPipeline)
+	validateCSVUsecase := usecase.NewValidateCSVUsecase(validationPipeline, strictness)
+	validateStreamUsecase := usecase.NewValidateStreamUsecase(validationPipeline, config.Int(app, "STREAM_CONCURRENCY", "8"))
+	autocompleteUsecase := usecase.NewAutocompleteUsecase(autocompleteIndex)
+	compareAddressesUsecase := usecase.NewCompareAddressesUsecase(validationPipeline)
+	jobsUsecase, webhooksUsecase, jobsEnabled := newJobsUsecase(app, validateAddressUsecase, strictness, &checks)
+	healthUsecase := newHealthUsecase(app, parser, localities, checks)
+	parseAddressUsecase := usecase.NewParseAddressUsecase(parser)
 
 	// Handlers
-	validateAddressHandler := handler.NewValidateAddressHandler(validateAddressUsecase)
+	healthHandler := handler.NewHealthHandler(healthUsecase)
+	healthHandler.Register(app)
+
+	validateAddressHandler := handler.NewValidateAddressHandler(validationPipeline)
 	validateAddressHandler.Register(app)
 
+	extractAddressesHandler := handler.NewExtractAddressesHandler(extractAddressesUsecase)
+	extractAddressesHandler.Register(app)
+
+	validateCSVHandler := handler.NewValidateCSVHandler(validateCSVUsecase)
+	validateCSVHandler.Register(app)
+
+	validateStreamHandler := handler.NewValidateStreamHandler(validateStreamUsecase)
+	validateStreamHandler.Register(app)
+
+	autocompleteHandler := handler.NewAutocompleteHandler(autocompleteUsecase)
+	autocompleteHandler.Register(app)
+
+	compareAddressesHandler := handler.NewCompareAddressesHandler(compareAddressesUsecase)
+	compareAddressesHandler.Register(app)
+
+	addressValidationGRPCHandler := handler.NewAddressValidationGRPCHandler(validationPipeline, validateStreamUsecase, compareAddressesUsecase)
+	addressValidationGRPCHandler.Register(app)
+
+	// Admin endpoints expose internals, such as the parser's raw labels, so each request
+	// must also present ADMIN_TOKEN.
+	if config.Bool(app, "ADMIN_ENDPOINTS", "false") {
+		adminToken := app.Config.Get("ADMIN_TOKEN")
+		if adminToken == "" {
+			app.Logger().Fatalf("ADMIN_ENDPOINTS requires ADMIN_TOKEN")
+		}
+		parseAddressHandler := handler.NewParseAddressHandler(parseAddressUsecase, adminToken)
+		parseAddressHandler.Register(app)
+	}
+
+	if jobsEnabled {
+		jobsHandler := handler.NewJobsHandler(jobsUsecase)
+		jobsHandler.Register(app)
+
+		webhooksHandler := handler.NewWebhooksHandler(webhooksUsecase)
+		webhooksHandler.Register(app)
+	}
+
 	app.Run()
 }
@@ -0,0 +1,46 @@
+// Command worker consumes validation jobs published by the API (VALIDATION_PIPELINE=async,
+// ASYNC_BROKER=pubsub) and stores their results in Redis for the API to return.
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/handler"
+	"github.com/williandandrade/address-validation-service/internal/config"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/cache"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func main() {
+	app := gofr.New()
+
+	if app.Config.Get("PUBSUB_BACKEND") == "" {
+		app.Logger().Fatalf("the worker requires PUBSUB_BACKEND")
+	}
+
+	// Infrastructure
+	parser := address_parser.NewGopostalParser()
+	results := cache.NewResultStore(config.RedisStore(app, "the worker"), config.Duration(app, "ASYNC_RESULT_TTL", "10m"))
+
+	localities, err := reference.NewLocalityStore()
+	if err != nil {
+		app.Logger().Fatalf("failed to load reference data: %v", err)
+	}
+
+	strictness := config.Strictness(app)
+
+	// Usecases
+	validateAddressUsecase := usecase.NewValidateAddressUsecase(parser, localities, usecase.ValidateAddressConfig{
+		DefaultStrictness: strictness,
+	})
+	processValidationJobUsecase := usecase.NewProcessValidationJobUsecase(validateAddressUsecase, results)
+
+	// Handlers
+	topic := app.Config.GetOrDefault("ASYNC_TOPIC", "address-validation")
+	validationJobHandler := handler.NewValidationJobHandler(topic, processValidationJobUsecase)
+	validationJobHandler.Register(app)
+
+	app.Run()
+}
@@ -0,0 +1,49 @@
+package dto
+
+// Suggestion types returned by the autocomplete endpoint.
+const (
+	SuggestionStreet = "street"
+	SuggestionCity   = "city"
+	SuggestionZIP    = "zip"
+)
+
+// AutocompleteRequest holds the query parameters of GET /api/v1/autocomplete.
+// ZIP, City and State narrow street and city suggestions in addition to any
+// context typed after a comma in Query.
+type AutocompleteRequest struct {
+	Query string
+	ZIP   string
+	City  string
+	State string
+	Limit int
+}
+
+// SuggestionDTO is one ranked completion. Score is in [0, 1], higher first.
+type SuggestionDTO struct {
+	Type          string  `json:"type"`
+	Text          string  `json:"text"`
+	StreetAddress string  `json:"street_address,omitempty"`
+	City          string  `json:"city,omitempty"`
+	State         string  `json:"state,omitempty"`
+	PostalCode    string  `json:"postal_code,omitempty"`
+	Score         float64 `json:"score"`
+}
+
+// AutocompleteResponse represents the response for the autocomplete endpoint.
+type AutocompleteResponse struct {
+	Success     bool             `json:"success"`
+	Query       string           `json:"query,omitempty"`
+	Suggestions []*SuggestionDTO `json:"suggestions"`
+	// Source names the reference data the suggestions come from, and Coverage how much
+	// of the US it covers: "sample" for the bundled data, "full" for a complete dataset.
+	Source   string     `json:"source,omitempty"`
+	Coverage string     `json:"coverage,omitempty"`
+	Errors   []ErrorDTO `json:"errors,omitempty"`
+	Message  string     `json:"message"`
+}
+
+// NewAutocompleteErrorResponse maps a domain error to a failed AutocompleteResponse.
+func NewAutocompleteErrorResponse(err error) *AutocompleteResponse {
+	resp := NewErrorResponse(err)
+	return &AutocompleteResponse{Success: false, Suggestions: []*SuggestionDTO{}, Errors: resp.Errors, Message: resp.Message}
+}
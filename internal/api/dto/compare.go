@@ -0,0 +1,53 @@
+package dto
+
+// Compare verdicts, from most to least alike.
+const (
+	MatchExact        = "exact"
+	MatchSameBuilding = "same_building"
+	MatchSameStreet   = "same_street"
+	MatchDifferent    = "different"
+)
+
+// Per-component comparison results. ComponentMissing means only one address has the component.
+const (
+	ComponentMatch     = "match"
+	ComponentDifferent = "different"
+	ComponentMissing   = "missing"
+)
+
+// CompareRequest represents the request body for comparing two addresses.
+// The options apply to both addresses, as in ValidateRequest.
+type CompareRequest struct {
+	AddressA   string `json:"address_a"`
+	AddressB   string `json:"address_b"`
+	Mode       string `json:"mode,omitempty"`
+	Strictness string `json:"strictness,omitempty"`
+}
+
+// ComponentComparisonDTO compares one component of the two addresses. A and B are
+// in USPS form, so they differ only when the component really differs.
+type ComponentComparisonDTO struct {
+	A          string  `json:"a"`
+	B          string  `json:"b"`
+	Result     string  `json:"result"`
+	Similarity float64 `json:"similarity"`
+}
+
+// CompareResponse represents the response for the compare endpoint.
+type CompareResponse struct {
+	Success bool `json:"success"`
+	// Verdict is exact, same_building (the units differ), same_street or different.
+	Verdict    string                             `json:"verdict,omitempty"`
+	Similarity float64                            `json:"similarity,omitempty"`
+	AddressA   *AddressDTO                        `json:"address_a,omitempty"`
+	AddressB   *AddressDTO                        `json:"address_b,omitempty"`
+	Components map[string]*ComponentComparisonDTO `json:"components,omitempty"`
+	Errors     []ErrorDTO                         `json:"errors,omitempty"`
+	Message    string                             `json:"message"`
+}
+
+// NewCompareErrorResponse maps a domain error to a failed CompareResponse.
+func NewCompareErrorResponse(err error) *CompareResponse {
+	resp := NewErrorResponse(err)
+	return &CompareResponse{Success: false, Errors: resp.Errors, Message: resp.Message}
+}
@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"errors"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// NewErrorResponse maps a domain error to a failed ValidateResponse. An error that
+// joins several problems gets one ErrorDTO per problem, and the message of the first.
+func NewErrorResponse(err error) *ValidateResponse {
+	resp := &ValidateResponse{Success: false}
+	for _, problem := range domainerrors.Problems(err) {
+		if errDTO, ok := newErrorDTO(problem); ok {
+			resp.Errors = append(resp.Errors, errDTO)
+		}
+	}
+
+	var (
+		validationErr *domainerrors.ValidationError
+		parsingErr    *domainerrors.ParsingError
+		timeoutErr    *domainerrors.TimeoutError
+		notFoundErr   *domainerrors.NotFoundError
+	)
+	switch {
+	case errors.As(err, &validationErr):
+		resp.SetMessage(MessageRequestInvalid)
+	case errors.As(err, &parsingErr):
+		resp.SetMessage(MessageNotNormalized)
+	case errors.As(err, &timeoutErr):
+		resp.SetMessage(MessageTimeout)
+	case errors.As(err, &notFoundErr):
+		resp.SetMessage(MessageNotFound)
+	default:
+		resp.Errors = nil
+		resp.SetMessage(MessageInternal)
+	}
+	return resp
+}
+
+// newErrorDTO describes a single domain error; it reports false for errors outside the catalog.
+func newErrorDTO(err error) (ErrorDTO, bool) {
+	code := domainerrors.CodeOf(err)
+
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		return ErrorDTO{
+			Code:       string(code),
+			DocsURL:    code.DocsURL(),
+			Field:      validationErr.Field,
+			Reason:     validationErr.Reason,
+			Value:      validationErr.Value,
+			Suggestion: validationErr.Suggestion,
+		}, true
+	}
+
+	var parsingErr *domainerrors.ParsingError
+	if errors.As(err, &parsingErr) {
+		errDTO := ErrorDTO{
+			Code:       string(code),
+			DocsURL:    code.DocsURL(),
+			Field:      parsingErr.Field,
+			Reason:     parsingErr.Reason,
+			Suggestion: parsingErr.Suggestion,
+		}
+		if parsingErr.Value != "" {
+			errDTO.Value = parsingErr.Value
+		}
+		return errDTO, true
+	}
+
+	var timeoutErr *domainerrors.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return ErrorDTO{
+			Code:       string(code),
+			DocsURL:    code.DocsURL(),
+			Field:      "address",
+			Reason:     timeoutErr.Reason,
+			Suggestion: "Retry the request; the result will be served once validation completes",
+		}, true
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return ErrorDTO{
+			Code:    string(code),
+			DocsURL: code.DocsURL(),
+			Field:   "id",
+			Reason:  notFoundErr.Error(),
+			Value:   notFoundErr.ID,
+		}, true
+	}
+
+	return ErrorDTO{}, false
+}
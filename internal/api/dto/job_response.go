@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+// JobResponse represents the response for job submission and status requests.
+type JobResponse struct {
+	Success    bool            `json:"success"`
+	Job        *JobDTO         `json:"job,omitempty"`
+	Results    []*JobResultDTO `json:"results,omitempty"`
+	Pagination *PaginationDTO  `json:"pagination,omitempty"`
+	Errors     []ErrorDTO      `json:"errors,omitempty"`
+	Message    string          `json:"message"`
+}
+
+// JobDTO represents a batch validation job's state and progress.
+type JobDTO struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Total     int    `json:"total"`
+	Processed int    `json:"processed"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	Error     string `json:"error,omitempty"`
+	// CallbackURL is echoed back so submitters can confirm where webhooks go.
+	CallbackURL string    `json:"callback_url,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// JobResultDTO represents the outcome for one submitted address. Result is
+// omitted while the address is pending.
+type JobResultDTO struct {
+	Index   int               `json:"index"`
+	Address string            `json:"address"`
+	Status  string            `json:"status"`
+	Result  *ValidateResponse `json:"result,omitempty"`
+}
+
+// PaginationDTO describes the page of results returned.
+type PaginationDTO struct {
+	Page       int `json:"page"`
+	PageSize   int `json:"page_size"`
+	TotalPages int `json:"total_pages"`
+}
+
+// NewJobErrorResponse maps a domain error to a failed JobResponse.
+func NewJobErrorResponse(err error) *JobResponse {
+	resp := NewErrorResponse(err)
+	return &JobResponse{Success: false, Errors: resp.Errors, Message: resp.Message}
+}
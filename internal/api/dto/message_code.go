@@ -0,0 +1,68 @@
+package dto
+
+// MessageCode identifies the message of a ValidateResponse. Codes are stable: clients
+// and translations match on them instead of the English text, which may be reworded.
+type MessageCode string
+
+// Message codes, one per message a ValidateResponse can carry.
+const (
+	// MessageValidated means the address was validated.
+	MessageValidated MessageCode = "VALIDATED"
+	// MessageAmbiguous means the address has several valid interpretations; candidates lists them.
+	MessageAmbiguous MessageCode = "AMBIGUOUS"
+	// MessageLowConfidence means the address scored below the requested min_confidence.
+	MessageLowConfidence MessageCode = "LOW_CONFIDENCE"
+	// MessageRequestInvalid means the request was rejected before validation.
+	MessageRequestInvalid MessageCode = "REQUEST_INVALID"
+	// MessageNotNormalized means the address failed validation; errors says why.
+	MessageNotNormalized MessageCode = "NOT_NORMALIZED"
+	// MessageTimeout means validation did not finish within its deadline.
+	MessageTimeout MessageCode = "TIMEOUT"
+	// MessageNotFound means the requested resource does not exist.
+	MessageNotFound MessageCode = "NOT_FOUND"
+	// MessageInternal means an unexpected failure.
+	MessageInternal MessageCode = "INTERNAL_ERROR"
+)
+
+// messageTexts is the English message for each code.
+var messageTexts = map[MessageCode]string{
+	MessageValidated:      "Address validated successfully",
+	MessageAmbiguous:      "Multiple valid interpretations found; returning most populous match",
+	MessageLowConfidence:  "Address confidence is below the requested minimum",
+	MessageRequestInvalid: "Request validation failed",
+	MessageNotNormalized:  "Address could not be normalized",
+	MessageTimeout:        "Validation did not complete in time",
+	MessageNotFound:       "Resource not found",
+	MessageInternal:       "Internal server error",
+}
+
+// MessageCodes returns every message code, so catalogs can be checked for each one.
+func MessageCodes() []MessageCode {
+	codes := make([]MessageCode, 0, len(messageTexts))
+	for code := range messageTexts {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// Text returns the English message for c.
+func (c MessageCode) Text() string {
+	return messageTexts[c]
+}
+
+// MessageCodeOf returns the code whose English message is text, for responses that
+// carry only the text: other response types, and results stored before codes existed.
+func MessageCodeOf(text string) (MessageCode, bool) {
+	for code, message := range messageTexts {
+		if message == text {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// SetMessage sets r's message code and its English message.
+func (r *ValidateResponse) SetMessage(code MessageCode) {
+	r.MessageCode = code
+	r.Message = code.Text()
+}
@@ -0,0 +1,29 @@
+package dto
+
+// ParseRequest represents the request body for the parse debug endpoint.
+type ParseRequest struct {
+	Address string `json:"address"`
+}
+
+// ParseLabelDTO is one component as the parser labeled it.
+type ParseLabelDTO struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// ParseResponse shows how the active parser read an address: its raw labels, in
+// parser order, and the components they normalize to, keyed by component name.
+type ParseResponse struct {
+	Success    bool              `json:"success"`
+	Parser     string            `json:"parser,omitempty"`
+	Labels     []*ParseLabelDTO  `json:"labels,omitempty"`
+	Normalized map[string]string `json:"normalized,omitempty"`
+	Errors     []ErrorDTO        `json:"errors,omitempty"`
+	Message    string            `json:"message"`
+}
+
+// NewParseErrorResponse maps a domain error to a failed ParseResponse.
+func NewParseErrorResponse(err error) *ParseResponse {
+	resp := NewErrorResponse(err)
+	return &ParseResponse{Success: false, Errors: resp.Errors, Message: resp.Message}
+}
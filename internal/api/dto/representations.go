@@ -0,0 +1,168 @@
+package dto
+
+import (
+	"encoding/xml"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Alternative serializations of ValidateResponse for clients that cannot take its JSON
+// shape. Each is built from the same response, with the JSON field names where the
+// format has names of its own.
+
+// validateResponseXML is ValidateResponse laid out for encoding/xml, which cannot
+// encode maps and names list items by their field. Lists are pointers so that empty
+// ones are left out: omitempty on an a>b path would still write an empty <a>.
+type validateResponseXML struct {
+	Success            bool                     `xml:"success"`
+	Status             string                   `xml:"status,omitempty"`
+	Strictness         string                   `xml:"strictness,omitempty"`
+	Address            *AddressDTO              `xml:"address,omitempty"`
+	Candidates         *xmlList[*AddressDTO]    `xml:"candidates"`
+	Confidence         *ConfidenceDTO           `xml:"confidence,omitempty"`
+	Corrections        *xmlList[*CorrectionDTO] `xml:"corrections"`
+	CorrectionsApplied *xmlList[string]         `xml:"corrections_applied"`
+	Components         *xmlList[componentXML]   `xml:"components"`
+	Metadata           *MetadataDTO             `xml:"metadata,omitempty"`
+	Trace              *xmlList[*DecisionDTO]   `xml:"trace"`
+	Warnings           *xmlList[WarningDTO]     `xml:"warnings"`
+	Errors             *xmlList[ErrorDTO]       `xml:"errors"`
+	MessageCode        MessageCode              `xml:"message_code,omitempty"`
+	Message            string                   `xml:"message"`
+}
+
+// xmlList is a list element whose items are each encoded as an element named item.
+type xmlList[T any] struct {
+	item  string
+	items []T
+}
+
+// newXMLList returns items as a list of item elements, or nil when there are none.
+func newXMLList[T any](item string, items []T) *xmlList[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	return &xmlList[T]{item: item, items: items}
+}
+
+// MarshalXML encodes the list as start wrapping one element per item.
+func (l *xmlList[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, item := range l.items {
+		if err := e.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: l.item}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// componentXML is one entry of ValidateResponse.Components, keyed by its name attribute.
+type componentXML struct {
+	Name string `xml:"name,attr"`
+	ComponentDTO
+}
+
+// MarshalXML encodes the response as a <validate_response> element. Components are
+// sorted by name so the output is stable.
+func (r ValidateResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	components := make([]componentXML, 0, len(r.Components))
+	for name, component := range r.Components {
+		components = append(components, componentXML{Name: name, ComponentDTO: *component})
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i].Name < components[j].Name })
+
+	out := validateResponseXML{
+		Success:            r.Success,
+		Status:             r.Status,
+		Strictness:         r.Strictness,
+		Address:            r.Address,
+		Candidates:         newXMLList("address", r.Candidates),
+		Confidence:         r.Confidence,
+		Corrections:        newXMLList("correction", r.Corrections),
+		CorrectionsApplied: newXMLList("correction", r.CorrectionsApplied),
+		Components:         newXMLList("component", components),
+		Metadata:           r.Metadata,
+		Trace:              newXMLList("decision", r.Trace),
+		Warnings:           newXMLList("warning", r.Warnings),
+		Errors:             newXMLList("error", r.Errors),
+		MessageCode:        r.MessageCode,
+		Message:            r.Message,
+	}
+
+	start.Name = xml.Name{Local: "validate_response"}
+	return e.EncodeElement(out, start)
+}
+
+// vCardCountry fills the country of every ADR; the service only handles US addresses.
+const vCardCountry = "USA"
+
+// vCardLineLimit is the length, in octets, at which vCard lines are folded (RFC 6350 §3.2).
+const vCardLineLimit = 75
+
+// adrComponents returns the seven ADR components of RFC 6350 §6.3.1: post office box,
+// extended address, street, locality, region, postal code and country.
+func adrComponents(a *AddressDTO) []string {
+	return []string{"", "", a.StreetAddress, a.City, a.State, a.PostalCode, vCardCountry}
+}
+
+// NewVCard returns the address as a vCard 4.0 of kind "location" (RFC 6350), with its
+// formatted address as FN and its components as ADR.
+func NewVCard(a *AddressDTO) string {
+	adr := adrComponents(a)
+	for i, component := range adr {
+		adr[i] = escapeVCardText(component)
+	}
+
+	var b strings.Builder
+	for _, line := range []string{
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"KIND:location",
+		"FN:" + escapeVCardText(a.FormattedAddress),
+		"ADR:" + strings.Join(adr, ";"),
+		"END:VCARD",
+	} {
+		b.WriteString(foldVCardLine(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// NewJCard returns the vCard of NewVCard in its JSON form (RFC 7095).
+func NewJCard(a *AddressDTO) []any {
+	return []any{"vcard", []any{
+		[]any{"version", map[string]any{}, "text", "4.0"},
+		[]any{"kind", map[string]any{}, "text", "location"},
+		[]any{"fn", map[string]any{}, "text", a.FormattedAddress},
+		[]any{"adr", map[string]any{}, "text", adrComponents(a)},
+	}}
+}
+
+var vCardTextEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeVCardText(s string) string {
+	return vCardTextEscaper.Replace(s)
+}
+
+// foldVCardLine splits line into chunks of at most vCardLineLimit octets, each
+// continuation starting with a space, without splitting a UTF-8 sequence.
+func foldVCardLine(line string) string {
+	var b strings.Builder
+	limit := vCardLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts towards the limit of continuation lines.
+		limit = vCardLineLimit - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
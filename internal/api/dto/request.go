@@ -1,6 +1,68 @@
 package dto
 
+import "encoding/json"
+
 // ValidateRequest represents the request body for single address validation.
 type ValidateRequest struct {
 	Address string `json:"address"`
+	// IncludeComponents adds per-component offsets into Address to the response.
+	IncludeComponents bool `json:"include_components,omitempty"`
+	// MinConfidence marks results whose overall score falls below it as unverifiable (0 disables).
+	MinConfidence float64 `json:"min_confidence,omitempty"`
+	// Mode selects the required components: "full" (default), "locality" or "postal".
+	Mode string `json:"mode,omitempty"`
+	// Strictness is "strict", "standard" or "lenient"; empty uses the service default.
+	Strictness string `json:"strictness,omitempty"`
+	// Format adds the address rendered as "single_line", "multi_line" or "usps_label".
+	Format string `json:"format,omitempty"`
+	// MaxLineLength caps each formatted line, abbreviating to fit (0 disables; requires Format).
+	MaxLineLength int `json:"max_line_length,omitempty"`
+	// Explain adds the ordered trace of parsing, reference, policy, scoring and
+	// standardization decisions to the response.
+	Explain bool `json:"explain,omitempty"`
+}
+
+// ExtractRequest represents the request body for extracting addresses from unstructured text.
+type ExtractRequest struct {
+	Text string `json:"text"`
+}
+
+// CreateJobRequest represents the request body for submitting a batch validation job.
+// The options apply to every address, as in ValidateRequest.
+type CreateJobRequest struct {
+	Addresses         []string `json:"addresses"`
+	IncludeComponents bool     `json:"include_components,omitempty"`
+	MinConfidence     float64  `json:"min_confidence,omitempty"`
+	Mode              string   `json:"mode,omitempty"`
+	Strictness        string   `json:"strictness,omitempty"`
+	Format            string   `json:"format,omitempty"`
+	MaxLineLength     int      `json:"max_line_length,omitempty"`
+	// CallbackURL receives signed webhooks for the job; empty disables them.
+	CallbackURL string `json:"callback_url,omitempty"`
+	// CallbackEvents selects "job.completed" and/or "item.completed"; empty means job.completed.
+	CallbackEvents []string `json:"callback_events,omitempty"`
+}
+
+// ValidateCSVRequest maps the columns of an uploaded CSV to address input. Either
+// AddressColumn names a free-form address column, or the component columns are
+// joined into one address. Columns are matched against the header row by name.
+// The options apply to every row, as in ValidateRequest.
+type ValidateCSVRequest struct {
+	AddressColumn    string `json:"address_column,omitempty"`
+	StreetColumn     string `json:"street_column,omitempty"`
+	Street2Column    string `json:"street2_column,omitempty"`
+	CityColumn       string `json:"city_column,omitempty"`
+	StateColumn      string `json:"state_column,omitempty"`
+	PostalCodeColumn string `json:"postal_code_column,omitempty"`
+
+	MinConfidence float64 `json:"min_confidence,omitempty"`
+	Mode          string  `json:"mode,omitempty"`
+	Strictness    string  `json:"strictness,omitempty"`
+}
+
+// StreamValidateRequest is one line of an NDJSON validation stream: a ValidateRequest
+// plus an optional id, any JSON value, that is echoed on the line's result.
+type StreamValidateRequest struct {
+	ID json.RawMessage `json:"id,omitempty"`
+	ValidateRequest
 }
@@ -1,43 +1,219 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 )
 
+// Validation statuses reported in ValidateResponse.Status.
+const (
+	StatusValid        = "valid"
+	StatusCorrected    = "corrected"
+	StatusUnverifiable = "unverifiable"
+)
+
 // ValidateResponse represents the API response for address validation.
 type ValidateResponse struct {
-	Success            bool           `json:"success"`
-	Address            *AddressDTO    `json:"address,omitempty"`
-	Candidates         []*AddressDTO  `json:"candidates,omitempty"`
-	Confidence         *ConfidenceDTO `json:"confidence,omitempty"`
-	CorrectionsApplied []string       `json:"corrections_applied,omitempty"`
-	Errors             []ErrorDTO     `json:"errors,omitempty"`
-	Message            string         `json:"message"`
+	Success            bool                     `json:"success"`
+	Status             string                   `json:"status,omitempty"`
+	Strictness         string                   `json:"strictness,omitempty"`
+	Address            *AddressDTO              `json:"address,omitempty"`
+	Candidates         []*AddressDTO            `json:"candidates,omitempty"`
+	Confidence         *ConfidenceDTO           `json:"confidence,omitempty"`
+	Corrections        []*CorrectionDTO         `json:"corrections,omitempty"`
+	CorrectionsApplied []string                 `json:"corrections_applied,omitempty"`
+	Components         map[string]*ComponentDTO `json:"components,omitempty"`
+	Metadata           *MetadataDTO             `json:"metadata,omitempty"`
+	Trace              []*DecisionDTO           `json:"trace,omitempty"`
+	Warnings           []WarningDTO             `json:"warnings,omitempty"`
+	Errors             []ErrorDTO               `json:"errors,omitempty"`
+	MessageCode        MessageCode              `json:"message_code,omitempty"`
+	Message            string                   `json:"message"`
+}
+
+// Clone returns a deep copy of r, so a response shared through a cache can be handed
+// to a caller that may change it.
+func (r *ValidateResponse) Clone() *ValidateResponse {
+	if r == nil {
+		return nil
+	}
+
+	out := *r
+	out.Address = r.Address.clone()
+	out.Candidates = nil
+	for _, cand := range r.Candidates {
+		out.Candidates = append(out.Candidates, cand.clone())
+	}
+	if r.Confidence != nil {
+		confidence := *r.Confidence
+		out.Confidence = &confidence
+	}
+	out.Corrections = nil
+	for _, c := range r.Corrections {
+		correction := *c
+		out.Corrections = append(out.Corrections, &correction)
+	}
+	out.CorrectionsApplied = append([]string(nil), r.CorrectionsApplied...)
+	if r.Components != nil {
+		out.Components = make(map[string]*ComponentDTO, len(r.Components))
+		for name, c := range r.Components {
+			component := *c
+			out.Components[name] = &component
+		}
+	}
+	if r.Metadata != nil {
+		metadata := *r.Metadata
+		out.Metadata = &metadata
+	}
+	out.Trace = nil
+	for _, d := range r.Trace {
+		decision := *d
+		out.Trace = append(out.Trace, &decision)
+	}
+	out.Warnings = append([]WarningDTO(nil), r.Warnings...)
+	out.Errors = append([]ErrorDTO(nil), r.Errors...)
+	return &out
 }
 
 // AddressDTO represents a normalized address in the response.
 type AddressDTO struct {
-	StreetAddress    string `json:"street_address"`
-	City             string `json:"city"`
-	State            string `json:"state"`
-	PostalCode       string `json:"postal_code"`
-	AddressType      string `json:"address_type"`
-	FormattedAddress string `json:"formatted_address,omitempty"`
+	StreetAddress    string `json:"street_address" xml:"street_address"`
+	City             string `json:"city" xml:"city"`
+	State            string `json:"state" xml:"state"`
+	PostalCode       string `json:"postal_code" xml:"postal_code"`
+	AddressType      string `json:"address_type" xml:"address_type"`
+	FormattedAddress string `json:"formatted_address,omitempty" xml:"formatted_address,omitempty"`
+	// CanonicalKey identifies the place for dedupe and joins; CanonicalHash is its
+	// fixed-length digest. Both start over when the key version changes.
+	CanonicalKey  string `json:"canonical_key,omitempty" xml:"canonical_key,omitempty"`
+	CanonicalHash string `json:"canonical_hash,omitempty" xml:"canonical_hash,omitempty"`
+	// Formatted is the address in the requested output format.
+	Formatted *FormattedDTO `json:"formatted,omitempty" xml:"formatted,omitempty"`
+}
+
+func (a *AddressDTO) clone() *AddressDTO {
+	if a == nil {
+		return nil
+	}
+	out := *a
+	if a.Formatted != nil {
+		formatted := *a.Formatted
+		formatted.Lines = append([]string(nil), a.Formatted.Lines...)
+		out.Formatted = &formatted
+	}
+	return &out
+}
+
+// FormattedDTO is an address rendered in a requested output format.
+type FormattedDTO struct {
+	Format        string `json:"format" xml:"format"`
+	MaxLineLength int    `json:"max_line_length,omitempty" xml:"max_line_length,omitempty"`
+	// Lines holds one entry per line; single_line has exactly one. Text joins them with newlines.
+	Lines []string `json:"lines" xml:"lines>line"`
+	Text  string   `json:"text" xml:"text"`
+	// Abbreviated reports that a line was shortened to fit; Truncated that one was still cut.
+	Abbreviated bool `json:"abbreviated,omitempty" xml:"abbreviated,omitempty"`
+	Truncated   bool `json:"truncated,omitempty" xml:"truncated,omitempty"`
+}
+
+// ComponentDTO locates a normalized component in the submitted address.
+// Start and End are character offsets into the raw input; End is exclusive.
+type ComponentDTO struct {
+	Value string `json:"value" xml:"value"`
+	Raw   string `json:"raw" xml:"raw"`
+	Start int    `json:"start" xml:"start"`
+	End   int    `json:"end" xml:"end"`
+}
+
+// CorrectionDTO is one change normalization made to the input, listed in
+// ValidateResponse.Corrections in the order applied. Type is "whitespace",
+// "capitalization", "state_name" or "dropped"; Component is empty for whitespace,
+// which applies to the whole input. ValidateResponse.CorrectionsApplied renders the
+// same list as the plain-text messages v1 clients read.
+type CorrectionDTO struct {
+	Component string `json:"component,omitempty" xml:"component,omitempty"`
+	Original  string `json:"original" xml:"original"`
+	Corrected string `json:"corrected" xml:"corrected"`
+	Type      string `json:"type" xml:"type"`
+	Reason    string `json:"reason" xml:"reason"`
+}
+
+// DecisionDTO is one step of an explained validation, listed in ValidateResponse.Trace
+// in the order taken: its stage (parse, reference, policy, confidence or standardize),
+// the rule that fired, the component and value it acted on, and why.
+type DecisionDTO struct {
+	Stage     string `json:"stage" xml:"stage"`
+	Rule      string `json:"rule" xml:"rule"`
+	Component string `json:"component,omitempty" xml:"component,omitempty"`
+	Value     string `json:"value,omitempty" xml:"value,omitempty"`
+	Detail    string `json:"detail" xml:"detail"`
+}
+
+// MetadataDTO reports how a response was produced.
+type MetadataDTO struct {
+	// Cache is "hit" or "miss" when a result cache is configured.
+	Cache string `json:"cache,omitempty" xml:"cache,omitempty"`
 }
 
 // ConfidenceDTO represents confidence levels for address components.
 type ConfidenceDTO struct {
-	StateConfidence  string `json:"state_confidence"`
-	CityConfidence   string `json:"city_confidence"`
-	PostalConfidence string `json:"postal_confidence"`
+	StateConfidence  string  `json:"state_confidence" xml:"state_confidence"`
+	CityConfidence   string  `json:"city_confidence" xml:"city_confidence"`
+	PostalConfidence string  `json:"postal_confidence" xml:"postal_confidence"`
+	StreetScore      float64 `json:"street_score" xml:"street_score"`
+	CityScore        float64 `json:"city_score" xml:"city_score"`
+	StateScore       float64 `json:"state_score" xml:"state_score"`
+	PostalScore      float64 `json:"postal_score" xml:"postal_score"`
+	Overall          float64 `json:"overall" xml:"overall"`
+}
+
+// ExtractResponse represents the API response for address extraction.
+type ExtractResponse struct {
+	Success   bool                   `json:"success"`
+	Addresses []*ExtractedAddressDTO `json:"addresses"`
+	Errors    []ErrorDTO             `json:"errors,omitempty"`
+	Message   string                 `json:"message"`
+}
+
+// ExtractedAddressDTO represents an address span found in text and its validation result.
+// Start and End are character offsets into the submitted text; End is exclusive.
+type ExtractedAddressDTO struct {
+	Text       string            `json:"text"`
+	Start      int               `json:"start"`
+	End        int               `json:"end"`
+	Confidence float64           `json:"confidence"`
+	Result     *ValidateResponse `json:"result"`
+}
+
+// StreamValidateResult is one line of an NDJSON validation stream. Results are written as
+// they finish, so they can arrive out of order; Line and ID tie each one to its input.
+type StreamValidateResult struct {
+	// CorrelationID echoes the request's X-Correlation-ID header on every line.
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	ID            json.RawMessage `json:"id,omitempty"`
+	// Line is the 1-based line number of the input.
+	Line   int               `json:"line"`
+	Result *ValidateResponse `json:"result"`
 }
 
-// ErrorDTO represents a field-level error in the response.
+// ErrorDTO represents a field-level error in the response. Code is the stable catalog
+// entry clients match on and DocsURL explains it; Reason is for people and may change.
 type ErrorDTO struct {
-	Field      string `json:"field"`
-	Reason     string `json:"reason"`
-	Value      any    `json:"value,omitempty"`
-	Suggestion string `json:"suggestion,omitempty"`
+	Code       string `json:"code" xml:"code"`
+	DocsURL    string `json:"docs_url" xml:"docs_url"`
+	Field      string `json:"field" xml:"field"`
+	Reason     string `json:"reason" xml:"reason"`
+	Value      any    `json:"value,omitempty" xml:"value,omitempty"`
+	Suggestion string `json:"suggestion,omitempty" xml:"suggestion,omitempty"`
+}
+
+// WarningDTO is a non-fatal issue with a validated address, such as an inferred or
+// corrected component. It uses the same catalog as ErrorDTO.
+type WarningDTO struct {
+	Code    string `json:"code" xml:"code"`
+	DocsURL string `json:"docs_url" xml:"docs_url"`
+	Field   string `json:"field" xml:"field"`
+	Message string `json:"message" xml:"message"`
 }
 
 // APIErrorResponse represents an API error response.
@@ -48,15 +224,33 @@ type APIErrorResponse struct {
 	RetryAfterSeconds int            `json:"retry_after_seconds,omitempty"`
 }
 
-// HealthResponse represents the health check response.
+// Health statuses reported by the liveness and readiness endpoints and by each check.
+const (
+	HealthStatusUp   = "up"
+	HealthStatusDown = "down"
+)
+
+// HealthResponse represents the liveness response: the process is running.
 type HealthResponse struct {
 	Status    string            `json:"status"`
 	Version   string            `json:"version"`
 	Timestamp time.Time         `json:"timestamp"`
-	Checks    map[string]string `json:"checks,omitempty"`
+	Versions  map[string]string `json:"versions,omitempty"`
 }
 
-// ReadinessResponse represents the readiness check response.
+// ReadinessResponse represents the readiness response. Ready is true once every check is up.
 type ReadinessResponse struct {
-	Ready bool `json:"ready"`
+	Ready     bool                       `json:"ready"`
+	Status    string                     `json:"status"`
+	Version   string                     `json:"version"`
+	Timestamp time.Time                  `json:"timestamp"`
+	Versions  map[string]string          `json:"versions,omitempty"`
+	Checks    map[string]*HealthCheckDTO `json:"checks"`
+}
+
+// HealthCheckDTO reports the outcome of one readiness check.
+type HealthCheckDTO struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMS int64  `json:"latency_ms"`
 }
@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"errors"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// Kinds of ValidationJobError, one per domain error the worker can report.
+const (
+	JobErrorValidation = "validation"
+	JobErrorParsing    = "parsing"
+	JobErrorInternal   = "internal"
+)
+
+// ValidationJob is the message the API publishes for the worker.
+type ValidationJob struct {
+	ID      string          `json:"id"`
+	Request ValidateRequest `json:"request"`
+}
+
+// ValidationJobResult is what the worker stores for a job: a response or an error.
+type ValidationJobResult struct {
+	Response *ValidateResponse   `json:"response,omitempty"`
+	Error    *ValidationJobError `json:"error,omitempty"`
+}
+
+// ValidationJobError carries a domain error between processes. When the error joins
+// several problems, the first is described inline and the rest are listed in More.
+type ValidationJobError struct {
+	Kind       string                `json:"kind"`
+	Code       string                `json:"code,omitempty"`
+	Field      string                `json:"field,omitempty"`
+	Reason     string                `json:"reason"`
+	Value      any                   `json:"value,omitempty"`
+	Suggestion string                `json:"suggestion,omitempty"`
+	More       []*ValidationJobError `json:"more,omitempty"`
+}
+
+// NewValidationJobError encodes err so the API can rebuild it with Err.
+func NewValidationJobError(err error) *ValidationJobError {
+	problems := domainerrors.Problems(err)
+	jobErr := newValidationJobError(problems[0])
+	for _, problem := range problems[1:] {
+		jobErr.More = append(jobErr.More, newValidationJobError(problem))
+	}
+	return jobErr
+}
+
+func newValidationJobError(err error) *ValidationJobError {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		return &ValidationJobError{
+			Kind:       JobErrorValidation,
+			Code:       string(validationErr.Code),
+			Field:      validationErr.Field,
+			Reason:     validationErr.Reason,
+			Value:      validationErr.Value,
+			Suggestion: validationErr.Suggestion,
+		}
+	}
+
+	var parsingErr *domainerrors.ParsingError
+	if errors.As(err, &parsingErr) {
+		jobErr := &ValidationJobError{
+			Kind:       JobErrorParsing,
+			Code:       string(parsingErr.Code),
+			Field:      parsingErr.Field,
+			Reason:     parsingErr.Reason,
+			Suggestion: parsingErr.Suggestion,
+		}
+		if parsingErr.Value != "" {
+			jobErr.Value = parsingErr.Value
+		}
+		return jobErr
+	}
+
+	return &ValidationJobError{Kind: JobErrorInternal, Reason: err.Error()}
+}
+
+// Err rebuilds the domain error the worker reported.
+func (e *ValidationJobError) Err() error {
+	if len(e.More) == 0 {
+		return e.err()
+	}
+	problems := []error{e.err()}
+	for _, more := range e.More {
+		problems = append(problems, more.err())
+	}
+	return errors.Join(problems...)
+}
+
+func (e *ValidationJobError) err() error {
+	switch e.Kind {
+	case JobErrorValidation:
+		return &domainerrors.ValidationError{Code: domainerrors.Code(e.Code), Field: e.Field, Reason: e.Reason, Value: e.Value, Suggestion: e.Suggestion}
+	case JobErrorParsing:
+		value, _ := e.Value.(string)
+		return &domainerrors.ParsingError{Code: domainerrors.Code(e.Code), Field: e.Field, Reason: e.Reason, Value: value, Suggestion: e.Suggestion}
+	default:
+		return errors.New(e.Reason)
+	}
+}
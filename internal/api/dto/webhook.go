@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+// WebhookPayload is the JSON body POSTed to a job's callback URL. Job is set for
+// job.completed and Item for item.completed.
+type WebhookPayload struct {
+	Event     string        `json:"event"`
+	JobID     string        `json:"job_id"`
+	Job       *JobDTO       `json:"job,omitempty"`
+	Item      *JobResultDTO `json:"item,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// WebhookDeliveryDTO represents one webhook delivery and its attempts.
+type WebhookDeliveryDTO struct {
+	ID            string    `json:"id"`
+	JobID         string    `json:"job_id"`
+	Event         string    `json:"event"`
+	URL           string    `json:"url"`
+	Status        string    `json:"status"`
+	Attempts      int       `json:"attempts"`
+	LastError     string    `json:"last_error,omitempty"`
+	NextAttemptAt time.Time `json:"next_attempt_at,omitzero"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// WebhookDeliveriesResponse represents the response for listing and redelivering webhooks.
+type WebhookDeliveriesResponse struct {
+	Success    bool                  `json:"success"`
+	Deliveries []*WebhookDeliveryDTO `json:"deliveries,omitempty"`
+	Errors     []ErrorDTO            `json:"errors,omitempty"`
+	Message    string                `json:"message"`
+}
+
+// NewWebhookErrorResponse maps a domain error to a failed WebhookDeliveriesResponse.
+func NewWebhookErrorResponse(err error) *WebhookDeliveriesResponse {
+	resp := NewErrorResponse(err)
+	return &WebhookDeliveriesResponse{Success: false, Errors: resp.Errors, Message: resp.Message}
+}
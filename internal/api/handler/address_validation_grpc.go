@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	pb "github.com/williandandrade/address-validation-service/internal/api/proto/addressvalidation/v1"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// maxGRPCBatchSize bounds ValidateBatch; larger workloads belong in ValidateStream or a job.
+const maxGRPCBatchSize = 1000
+
+// AddressValidationGRPCHandler serves the AddressValidationService gRPC service with
+// the usecases behind the HTTP API.
+type AddressValidationGRPCHandler struct {
+	pb.UnimplementedAddressValidationServiceServer
+
+	validateAddressUsecase  usecase.ValidateAddressUsecaseInterface
+	validateStreamUsecase   usecase.ValidateStreamUsecaseInterface
+	compareAddressesUsecase usecase.CompareAddressesUsecaseInterface
+}
+
+// NewAddressValidationGRPCHandler creates a new AddressValidationGRPCHandler.
+func NewAddressValidationGRPCHandler(
+	validateAddressUsecase usecase.ValidateAddressUsecaseInterface,
+	validateStreamUsecase usecase.ValidateStreamUsecaseInterface,
+	compareAddressesUsecase usecase.CompareAddressesUsecaseInterface,
+) *AddressValidationGRPCHandler {
+	return &AddressValidationGRPCHandler{
+		validateAddressUsecase:  validateAddressUsecase,
+		validateStreamUsecase:   validateStreamUsecase,
+		compareAddressesUsecase: compareAddressesUsecase,
+	}
+}
+
+// Register registers the service with GoFr's gRPC server, which listens on GRPC_PORT.
+func (h *AddressValidationGRPCHandler) Register(app *gofr.App) {
+	pb.RegisterAddressValidationServiceServer(app, h)
+}
+
+// Validate normalizes one address.
+func (h *AddressValidationGRPCHandler) Validate(ctx context.Context, in *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	lang := grpcLanguage(ctx)
+	resp, err := h.validateAddressUsecase.Execute(ctx, validateRequestFromProto(in))
+	if err != nil {
+		return nil, grpcError(lang, err)
+	}
+	return validateResponseToProto(lang.ValidateResponse(resp)), nil
+}
+
+// ValidateBatch validates every request with the stream usecase's concurrency and
+// returns the results in request order.
+func (h *AddressValidationGRPCHandler) ValidateBatch(ctx context.Context, in *pb.ValidateBatchRequest) (*pb.ValidateBatchResponse, error) {
+	lang := grpcLanguage(ctx)
+	requests := in.GetRequests()
+	if len(requests) > maxGRPCBatchSize {
+		return nil, grpcError(lang, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeLimitExceeded,
+			Field:      "requests",
+			Reason:     "too many requests in one batch",
+			Value:      len(requests),
+			Suggestion: "Send at most 1000 requests per batch, or use ValidateStream",
+		})
+	}
+
+	next := 0
+	receive := func() (*dto.StreamValidateRequest, error) {
+		if next == len(requests) {
+			return nil, io.EOF
+		}
+		next++
+		return &dto.StreamValidateRequest{ValidateRequest: *validateRequestFromProto(requests[next-1])}, nil
+	}
+
+	results := make([]*pb.ValidateResult, len(requests))
+	send := func(result *dto.StreamValidateResult, err error) error {
+		results[result.Line-1] = validateResultToProto(lang, result.Result, err)
+		return nil
+	}
+
+	if _, err := h.validateStreamUsecase.Stream(ctx, receive, send); err != nil {
+		return nil, grpcError(lang, err)
+	}
+	return &pb.ValidateBatchResponse{Results: results}, nil
+}
+
+// ValidateStream validates requests as they arrive and sends each result once it is ready.
+func (h *AddressValidationGRPCHandler) ValidateStream(stream pb.AddressValidationService_ValidateStreamServer) error {
+	lang := grpcLanguage(stream.Context())
+	receive := func() (*dto.StreamValidateRequest, error) {
+		in, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		request := &dto.StreamValidateRequest{ValidateRequest: *validateRequestFromProto(in.GetRequest())}
+		if in.GetId() != "" {
+			request.ID, _ = json.Marshal(in.GetId())
+		}
+		return request, nil
+	}
+
+	send := func(result *dto.StreamValidateResult, err error) error {
+		var id string
+		if result.ID != nil {
+			_ = json.Unmarshal(result.ID, &id)
+		}
+		return stream.Send(&pb.ValidateStreamResponse{
+			Id:       id,
+			Sequence: int64(result.Line),
+			Result:   validateResultToProto(lang, result.Result, err),
+		})
+	}
+
+	if _, err := h.validateStreamUsecase.Stream(stream.Context(), receive, send); err != nil {
+		return grpcError(lang, err)
+	}
+	return nil
+}
+
+// Compare reports whether two addresses describe the same place.
+func (h *AddressValidationGRPCHandler) Compare(ctx context.Context, in *pb.CompareRequest) (*pb.CompareResponse, error) {
+	resp, err := h.compareAddressesUsecase.Execute(ctx, &dto.CompareRequest{
+		AddressA:   in.GetAddressA(),
+		AddressB:   in.GetAddressB(),
+		Mode:       in.GetMode(),
+		Strictness: in.GetStrictness(),
+	})
+	if err != nil {
+		return nil, grpcError(grpcLanguage(ctx), err)
+	}
+	return compareResponseToProto(resp), nil
+}
+
+// grpcErrorDomain scopes the catalog codes reported as ErrorInfo reasons.
+const grpcErrorDomain = "addressvalidation.v1"
+
+// grpcError converts a usecase error to a gRPC status that says what its HTTP error
+// response says: the code follows errorStatus, the message is the response's message
+// and first reason, the first error's catalog code and its docs URL are attached as a
+// google.rpc.ErrorInfo, and every field error, with its own code, as a google.rpc.BadRequest.
+// The message and reasons are in lang. Status errors, such as a failed Recv, and context
+// errors keep their own code.
+func grpcError(lang i18n.Language, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if st := status.FromContextError(err); st.Code() != codes.Unknown {
+		return st.Err()
+	}
+
+	_, code := errorStatus(err)
+	resp := lang.ValidateResponse(dto.NewErrorResponse(err))
+	if len(resp.Errors) == 0 {
+		return status.Error(code, resp.Message)
+	}
+
+	st := status.New(code, resp.Message+": "+resp.Errors[0].Reason)
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: e.Field, Description: e.Reason, Reason: e.Code})
+	}
+	info := &errdetails.ErrorInfo{
+		Reason:   resp.Errors[0].Code,
+		Domain:   grpcErrorDomain,
+		Metadata: map[string]string{"docs_url": resp.Errors[0].DocsURL},
+	}
+	if detailed, detailErr := st.WithDetails(info, &errdetails.BadRequest{FieldViolations: violations}); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// grpcLanguage returns the language the call's accept-language metadata prefers, the
+// gRPC counterpart of the Accept-Language header.
+func grpcLanguage(ctx context.Context) i18n.Language {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return i18n.Negotiate(strings.Join(md.Get("accept-language"), ","))
+}
+
+func validateRequestFromProto(in *pb.ValidateRequest) *dto.ValidateRequest {
+	return &dto.ValidateRequest{
+		Address:           in.GetAddress(),
+		IncludeComponents: in.GetIncludeComponents(),
+		MinConfidence:     in.GetMinConfidence(),
+		Mode:              in.GetMode(),
+		Strictness:        in.GetStrictness(),
+		Format:            in.GetFormat(),
+		MaxLineLength:     int(in.GetMaxLineLength()),
+		Explain:           in.GetExplain(),
+	}
+}
+
+// validateResultToProto wraps the outcome of one batch or stream request, in lang.
+func validateResultToProto(lang i18n.Language, resp *dto.ValidateResponse, err error) *pb.ValidateResult {
+	if err != nil {
+		return &pb.ValidateResult{Outcome: &pb.ValidateResult_Error{Error: status.Convert(grpcError(lang, err)).Proto()}}
+	}
+	return &pb.ValidateResult{Outcome: &pb.ValidateResult_Response{Response: validateResponseToProto(lang.ValidateResponse(resp))}}
+}
+
+func validateResponseToProto(resp *dto.ValidateResponse) *pb.ValidateResponse {
+	out := &pb.ValidateResponse{
+		Status:             resp.Status,
+		Strictness:         resp.Strictness,
+		Address:            addressToProto(resp.Address),
+		CorrectionsApplied: resp.CorrectionsApplied,
+		Message:            resp.Message,
+	}
+	for _, cand := range resp.Candidates {
+		out.Candidates = append(out.Candidates, addressToProto(cand))
+	}
+	if c := resp.Confidence; c != nil {
+		out.Confidence = &pb.Confidence{
+			StateConfidence:  c.StateConfidence,
+			CityConfidence:   c.CityConfidence,
+			PostalConfidence: c.PostalConfidence,
+			StreetScore:      c.StreetScore,
+			CityScore:        c.CityScore,
+			StateScore:       c.StateScore,
+			PostalScore:      c.PostalScore,
+			Overall:          c.Overall,
+		}
+	}
+	if len(resp.Components) > 0 {
+		out.Components = make(map[string]*pb.Component, len(resp.Components))
+		for name, c := range resp.Components {
+			out.Components[name] = &pb.Component{Value: c.Value, Raw: c.Raw, Start: int32(c.Start), End: int32(c.End)}
+		}
+	}
+	if resp.Metadata != nil {
+		out.Cache = resp.Metadata.Cache
+	}
+	for _, c := range resp.Corrections {
+		out.Corrections = append(out.Corrections, &pb.Correction{
+			Component: c.Component,
+			Original:  c.Original,
+			Corrected: c.Corrected,
+			Type:      c.Type,
+			Reason:    c.Reason,
+		})
+	}
+	for _, w := range resp.Warnings {
+		out.Warnings = append(out.Warnings, &pb.Warning{
+			Code:    w.Code,
+			DocsUrl: w.DocsURL,
+			Field:   w.Field,
+			Message: w.Message,
+		})
+	}
+	for _, d := range resp.Trace {
+		out.Trace = append(out.Trace, &pb.Decision{
+			Stage:     d.Stage,
+			Rule:      d.Rule,
+			Component: d.Component,
+			Value:     d.Value,
+			Detail:    d.Detail,
+		})
+	}
+	return out
+}
+
+func addressToProto(addr *dto.AddressDTO) *pb.Address {
+	if addr == nil {
+		return nil
+	}
+
+	out := &pb.Address{
+		StreetAddress:    addr.StreetAddress,
+		City:             addr.City,
+		State:            addr.State,
+		PostalCode:       addr.PostalCode,
+		AddressType:      addr.AddressType,
+		FormattedAddress: addr.FormattedAddress,
+		CanonicalKey:     addr.CanonicalKey,
+		CanonicalHash:    addr.CanonicalHash,
+	}
+	if f := addr.Formatted; f != nil {
+		out.Formatted = &pb.FormattedAddress{
+			Format:        f.Format,
+			MaxLineLength: int32(f.MaxLineLength),
+			Lines:         f.Lines,
+			Text:          f.Text,
+			Abbreviated:   f.Abbreviated,
+			Truncated:     f.Truncated,
+		}
+	}
+	return out
+}
+
+func compareResponseToProto(resp *dto.CompareResponse) *pb.CompareResponse {
+	out := &pb.CompareResponse{
+		Verdict:    resp.Verdict,
+		Similarity: resp.Similarity,
+		AddressA:   addressToProto(resp.AddressA),
+		AddressB:   addressToProto(resp.AddressB),
+		Message:    resp.Message,
+	}
+	if len(resp.Components) > 0 {
+		out.Components = make(map[string]*pb.ComponentComparison, len(resp.Components))
+		for name, c := range resp.Components {
+			out.Components[name] = &pb.ComponentComparison{A: c.A, B: c.B, Result: c.Result, Similarity: c.Similarity}
+		}
+	}
+	return out
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	pb "github.com/williandandrade/address-validation-service/internal/api/proto/addressvalidation/v1"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+type grpcMocks struct {
+	validate *usecase.MockValidateAddressUsecaseInterface
+	stream   *usecase.MockValidateStreamUsecaseInterface
+	compare  *usecase.MockCompareAddressesUsecaseInterface
+}
+
+func newGRPCHandler(t *testing.T) (*AddressValidationGRPCHandler, grpcMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := grpcMocks{
+		validate: usecase.NewMockValidateAddressUsecaseInterface(ctrl),
+		stream:   usecase.NewMockValidateStreamUsecaseInterface(ctrl),
+		compare:  usecase.NewMockCompareAddressesUsecaseInterface(ctrl),
+	}
+	return NewAddressValidationGRPCHandler(mocks.validate, mocks.stream, mocks.compare), mocks
+}
+
+// runStream answers Stream by validating each received request with validate.
+func runStream(validate func(*dto.ValidateRequest) (*dto.ValidateResponse, error)) func(
+	context.Context, func() (*dto.StreamValidateRequest, error), func(*dto.StreamValidateResult, error) error,
+) (int, error) {
+	return func(_ context.Context, receive func() (*dto.StreamValidateRequest, error), send func(*dto.StreamValidateResult, error) error) (int, error) {
+		sent := 0
+		for {
+			request, err := receive()
+			if err == io.EOF {
+				return sent, nil
+			}
+			if err != nil {
+				return sent, err
+			}
+			sent++
+			resp, err := validate(&request.ValidateRequest)
+			if err := send(&dto.StreamValidateResult{ID: request.ID, Line: sent, Result: resp}, err); err != nil {
+				return sent, err
+			}
+		}
+	}
+}
+
+func TestAddressValidationGRPCHandler_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		h, mocks := newGRPCHandler(t)
+		mocks.validate.EXPECT().
+			Execute(gomock.Any(), &dto.ValidateRequest{Address: "123 Main St, Springfield, IL", Format: "usps_label", MaxLineLength: 35}).
+			Return(&dto.ValidateResponse{
+				Success: true,
+				Status:  dto.StatusValid,
+				Address: &dto.AddressDTO{
+					StreetAddress: "123 Main St",
+					City:          "Springfield",
+					State:         "IL",
+					Formatted:     &dto.FormattedDTO{Format: "usps_label", Lines: []string{"123 MAIN ST", "SPRINGFIELD IL"}},
+				},
+				Components: map[string]*dto.ComponentDTO{"city": {Value: "Springfield", Raw: "Springfield", Start: 13, End: 24}},
+				Metadata:   &dto.MetadataDTO{Cache: "miss"},
+				Warnings:   []dto.WarningDTO{{Code: "COMPONENT_CORRECTED", Field: "city", Message: "city was changed from springfield to Springfield"}},
+				Message:    "Address validated successfully",
+			}, nil)
+
+		resp, err := h.Validate(context.Background(), &pb.ValidateRequest{Address: "123 Main St, Springfield, IL", Format: "usps_label", MaxLineLength: 35})
+
+		require.NoError(t, err)
+		assert.Equal(t, "valid", resp.GetStatus())
+		assert.Equal(t, "Springfield", resp.GetAddress().GetCity())
+		assert.Equal(t, []string{"123 MAIN ST", "SPRINGFIELD IL"}, resp.GetAddress().GetFormatted().GetLines())
+		assert.Equal(t, int32(13), resp.GetComponents()["city"].GetStart())
+		assert.Equal(t, "miss", resp.GetCache())
+		require.Len(t, resp.GetWarnings(), 1)
+		assert.Equal(t, "COMPONENT_CORRECTED", resp.GetWarnings()[0].GetCode())
+	})
+
+	t.Run("domain errors map to status codes", func(t *testing.T) {
+		tests := []struct {
+			err  error
+			code codes.Code
+		}{
+			{&domainerrors.ValidationError{Field: "mode", Reason: "mode must be one of full, locality, postal"}, codes.InvalidArgument},
+			{&domainerrors.ParsingError{Field: "address", Reason: "at least 2 of street_address, city, state must be present"}, codes.InvalidArgument},
+			{&domainerrors.TimeoutError{Reason: "no result after 5s"}, codes.DeadlineExceeded},
+			{context.Canceled, codes.Canceled},
+			{io.ErrUnexpectedEOF, codes.Internal},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.code.String(), func(t *testing.T) {
+				h, mocks := newGRPCHandler(t)
+				mocks.validate.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+				_, err := h.Validate(context.Background(), &pb.ValidateRequest{Address: "x"})
+
+				assert.Equal(t, tt.code, status.Code(err))
+			})
+		}
+	})
+
+	t.Run("error code and field errors are attached as details", func(t *testing.T) {
+		h, mocks := newGRPCHandler(t)
+		mocks.validate.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, &domainerrors.ValidationError{
+			Code:   domainerrors.CodeAddressEmpty,
+			Field:  "address",
+			Reason: "address field is required and cannot be empty",
+		})
+
+		_, err := h.Validate(context.Background(), &pb.ValidateRequest{})
+
+		st := status.Convert(err)
+		assert.Equal(t, "Request validation failed: address field is required and cannot be empty", st.Message())
+		require.Len(t, st.Details(), 2)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, "ADDRESS_EMPTY", info.GetReason())
+		assert.Equal(t, domainerrors.CodeAddressEmpty.DocsURL(), info.GetMetadata()["docs_url"])
+		badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "address", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("every problem is a field violation with its own code", func(t *testing.T) {
+		h, mocks := newGRPCHandler(t)
+		mocks.validate.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.Join(
+			&domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "address", Reason: "invalid state code: XX"},
+			&domainerrors.ParsingError{Code: domainerrors.CodeZIPFormat, Field: "address", Reason: "postal_code must be 5 or 9-digit format"},
+		))
+
+		_, err := h.Validate(context.Background(), &pb.ValidateRequest{Address: "123 Main St, Faketown, XX 123"})
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		require.Len(t, st.Details(), 2)
+		badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.GetFieldViolations(), 2)
+		assert.Equal(t, "STATE_INVALID", badRequest.GetFieldViolations()[0].GetReason())
+		assert.Equal(t, "ZIP_FORMAT", badRequest.GetFieldViolations()[1].GetReason())
+	})
+
+	t.Run("messages follow the accept-language metadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "es-MX, en;q=0.5"))
+
+		h, mocks := newGRPCHandler(t)
+		mocks.validate.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&dto.ValidateResponse{
+			Success:     true,
+			Status:      dto.StatusCorrected,
+			Corrections: []*dto.CorrectionDTO{{Component: "city", Original: "springfield", Corrected: "Springfield", Type: "capitalization"}},
+			Warnings:    []dto.WarningDTO{{Code: "COMPONENT_CORRECTED", Field: "city", Message: "city was changed from springfield to Springfield"}},
+			Message:     "Address validated successfully",
+		}, nil)
+		mocks.validate.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, &domainerrors.ValidationError{
+			Code:   domainerrors.CodeAddressEmpty,
+			Field:  "address",
+			Reason: "address field is required and cannot be empty",
+		})
+
+		resp, err := h.Validate(ctx, &pb.ValidateRequest{Address: "123 Main St, springfield, IL"})
+		require.NoError(t, err)
+		assert.Equal(t, "Dirección validada correctamente", resp.GetMessage())
+		assert.Equal(t, "city se cambió de springfield a Springfield", resp.GetWarnings()[0].GetMessage())
+
+		_, err = h.Validate(ctx, &pb.ValidateRequest{})
+		assert.Equal(t, "La solicitud no es válida: La dirección es obligatoria y no puede estar vacía", status.Convert(err).Message())
+	})
+}
+
+func TestAddressValidationGRPCHandler_ValidateBatch(t *testing.T) {
+	h, mocks := newGRPCHandler(t)
+	mocks.stream.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		runStream(func(input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			if input.Address == "" {
+				return nil, &domainerrors.ValidationError{Field: "address", Reason: "address field is required and cannot be empty"}
+			}
+			return &dto.ValidateResponse{Success: true, Status: dto.StatusValid, Strictness: input.Strictness}, nil
+		}))
+
+	resp, err := h.ValidateBatch(context.Background(), &pb.ValidateBatchRequest{Requests: []*pb.ValidateRequest{
+		{Address: "123 Main St, Springfield, IL", Strictness: "lenient"},
+		{Address: ""},
+	}})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 2)
+	assert.Equal(t, "lenient", resp.GetResults()[0].GetResponse().GetStrictness())
+	assert.Equal(t, int32(codes.InvalidArgument), resp.GetResults()[1].GetError().GetCode())
+
+	t.Run("too many requests", func(t *testing.T) {
+		h, _ := newGRPCHandler(t)
+
+		_, err := h.ValidateBatch(context.Background(), &pb.ValidateBatchRequest{Requests: make([]*pb.ValidateRequest, maxGRPCBatchSize+1)})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// fakeValidateStream is a server-side ValidateStream fed from a slice.
+type fakeValidateStream struct {
+	grpc.ServerStream
+	in  []*pb.ValidateStreamRequest
+	out []*pb.ValidateStreamResponse
+}
+
+func (s *fakeValidateStream) Context() context.Context { return context.Background() }
+
+func (s *fakeValidateStream) Recv() (*pb.ValidateStreamRequest, error) {
+	if len(s.in) == 0 {
+		return nil, io.EOF
+	}
+	request := s.in[0]
+	s.in = s.in[1:]
+	return request, nil
+}
+
+func (s *fakeValidateStream) Send(resp *pb.ValidateStreamResponse) error {
+	s.out = append(s.out, resp)
+	return nil
+}
+
+func TestAddressValidationGRPCHandler_ValidateStream(t *testing.T) {
+	h, mocks := newGRPCHandler(t)
+	mocks.stream.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		runStream(func(input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			return &dto.ValidateResponse{Success: true, Message: input.Address}, nil
+		}))
+
+	stream := &fakeValidateStream{in: []*pb.ValidateStreamRequest{
+		{Id: "first", Request: &pb.ValidateRequest{Address: "123 Main St, Springfield, IL"}},
+		{Request: &pb.ValidateRequest{Address: "83702"}},
+	}}
+
+	require.NoError(t, h.ValidateStream(stream))
+
+	require.Len(t, stream.out, 2)
+	assert.Equal(t, "first", stream.out[0].GetId())
+	assert.Equal(t, int64(1), stream.out[0].GetSequence())
+	assert.Equal(t, "123 Main St, Springfield, IL", stream.out[0].GetResult().GetResponse().GetMessage())
+	assert.Empty(t, stream.out[1].GetId())
+	assert.Equal(t, int64(2), stream.out[1].GetSequence())
+}
+
+func TestAddressValidationGRPCHandler_Compare(t *testing.T) {
+	h, mocks := newGRPCHandler(t)
+	mocks.compare.EXPECT().
+		Execute(gomock.Any(), &dto.CompareRequest{AddressA: "123 Main St, Springfield, IL", AddressB: "123 Main Street, Springfield, IL", Mode: "full"}).
+		Return(&dto.CompareResponse{
+			Success:    true,
+			Verdict:    dto.MatchExact,
+			Similarity: 1,
+			Components: map[string]*dto.ComponentComparisonDTO{"street": {A: "MAIN ST", B: "MAIN ST", Result: dto.ComponentMatch, Similarity: 1}},
+		}, nil)
+
+	resp, err := h.Compare(context.Background(), &pb.CompareRequest{AddressA: "123 Main St, Springfield, IL", AddressB: "123 Main Street, Springfield, IL", Mode: "full"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "exact", resp.GetVerdict())
+	assert.Equal(t, "match", resp.GetComponents()["street"].GetResult())
+}
+
+func TestValidateResultToProto(t *testing.T) {
+	result := validateResultToProto(i18n.English, nil, &domainerrors.ParsingError{Field: "address", Reason: "could not parse"})
+
+	require.NotNil(t, result.GetError())
+	assert.Equal(t, "Address could not be normalized: could not parse", result.GetError().GetMessage())
+
+	ok := validateResultToProto(i18n.English, &dto.ValidateResponse{Status: dto.StatusValid}, nil)
+	assert.Equal(t, "valid", ok.GetResponse().GetStatus())
+}
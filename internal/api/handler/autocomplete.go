@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// AutocompleteHandler handles GET /api/v1/autocomplete requests.
+type AutocompleteHandler struct {
+	autocompleteUsecase usecase.AutocompleteUsecaseInterface
+}
+
+// NewAutocompleteHandler creates a new AutocompleteHandler.
+func NewAutocompleteHandler(autocompleteUsecase usecase.AutocompleteUsecaseInterface) *AutocompleteHandler {
+	return &AutocompleteHandler{
+		autocompleteUsecase: autocompleteUsecase,
+	}
+}
+
+// Register registers the autocomplete route with the GoFr app.
+func (a *AutocompleteHandler) Register(app *gofr.App) {
+	app.GET("/api/v1/autocomplete", func(ctx *gofr.Context) (any, error) {
+		return a.Handle(ctx)
+	})
+}
+
+// Handle returns ranked completions for the partial address in q.
+func (a *AutocompleteHandler) Handle(ctx *gofr.Context) (any, error) {
+	limit, err := intParam(ctx, "limit")
+	if err != nil {
+		return autocompleteErrorResponse(ctx, err), nil
+	}
+
+	resp, err := a.autocompleteUsecase.Execute(ctx, &dto.AutocompleteRequest{
+		Query: ctx.Param("q"),
+		ZIP:   ctx.Param("zip"),
+		City:  ctx.Param("city"),
+		State: ctx.Param("state"),
+		Limit: limit,
+	})
+	if err != nil {
+		return autocompleteErrorResponse(ctx, err), nil
+	}
+
+	return resp, nil
+}
+
+// autocompleteErrorResponse reports err in the request's language.
+func autocompleteErrorResponse(ctx *gofr.Context, err error) *dto.AutocompleteResponse {
+	resp := dto.NewAutocompleteErrorResponse(err)
+	resp.Errors, resp.Message = localizeErrors(ctx, resp.Errors, resp.Message)
+	return resp
+}
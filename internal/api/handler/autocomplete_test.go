@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestAutocompleteHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		setupMocks    func(*usecase.MockAutocompleteUsecaseInterface)
+		checkResponse func(t *testing.T, resp *dto.AutocompleteResponse)
+	}{
+		{
+			name:   "query parameters reach the usecase",
+			target: "/api/v1/autocomplete?q=123+Main+&zip=02139&city=Cambridge&state=MA&limit=5",
+			setupMocks: func(m *usecase.MockAutocompleteUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), &dto.AutocompleteRequest{
+						Query: "123 Main ", ZIP: "02139", City: "Cambridge", State: "MA", Limit: 5,
+					}).
+					Return(&dto.AutocompleteResponse{
+						Success: true,
+						Query:   "123 Main ",
+						Suggestions: []*dto.SuggestionDTO{
+							{Type: dto.SuggestionStreet, Text: "123 Main St, Cambridge, MA 02139", Score: 0.71},
+						},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *dto.AutocompleteResponse) {
+				assert.True(t, resp.Success)
+				require.Len(t, resp.Suggestions, 1)
+				assert.Equal(t, "street", resp.Suggestions[0].Type)
+			},
+		},
+		{
+			name:   "non-numeric limit",
+			target: "/api/v1/autocomplete?q=spr&limit=ten",
+			checkResponse: func(t *testing.T, resp *dto.AutocompleteResponse) {
+				assert.False(t, resp.Success)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "limit", resp.Errors[0].Field)
+				assert.NotNil(t, resp.Suggestions)
+			},
+		},
+		{
+			name:   "usecase validation error",
+			target: "/api/v1/autocomplete",
+			setupMocks: func(m *usecase.MockAutocompleteUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(nil, &domainerrors.ValidationError{Field: "q", Reason: "q is required and cannot be empty"})
+			},
+			checkResponse: func(t *testing.T, resp *dto.AutocompleteResponse) {
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Request validation failed", resp.Message)
+				assert.Equal(t, "q", resp.Errors[0].Field)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockAutocompleteUsecaseInterface(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockUsecase)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			ctx := &gofr.Context{
+				Context:   req.Context(),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := NewAutocompleteHandler(mockUsecase).Handle(ctx)
+
+			require.NoError(t, err)
+			resp, ok := result.(*dto.AutocompleteResponse)
+			require.True(t, ok)
+			tt.checkResponse(t, resp)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// CompareAddressesHandler handles POST /api/v1/compare requests.
+type CompareAddressesHandler struct {
+	compareAddressesUsecase usecase.CompareAddressesUsecaseInterface
+}
+
+// NewCompareAddressesHandler creates a new CompareAddressesHandler.
+func NewCompareAddressesHandler(compareAddressesUsecase usecase.CompareAddressesUsecaseInterface) *CompareAddressesHandler {
+	return &CompareAddressesHandler{
+		compareAddressesUsecase: compareAddressesUsecase,
+	}
+}
+
+// Register registers the compare route with the GoFr app.
+func (c *CompareAddressesHandler) Register(app *gofr.App) {
+	app.POST("/api/v1/compare", func(ctx *gofr.Context) (any, error) {
+		return c.Handle(ctx)
+	})
+}
+
+// Handle processes the compare request.
+func (c *CompareAddressesHandler) Handle(ctx *gofr.Context) (any, error) {
+	request := new(dto.CompareRequest)
+	if err := ctx.Bind(request); err != nil {
+		resp := &dto.CompareResponse{
+			Success: false,
+			Errors: []dto.ErrorDTO{
+				{
+					Code:       string(domainerrors.CodeRequestMalformed),
+					DocsURL:    domainerrors.CodeRequestMalformed.DocsURL(),
+					Field:      "address_a",
+					Reason:     "Invalid request format",
+					Suggestion: "Provide a JSON body with 'address_a' and 'address_b' fields",
+				},
+			},
+			Message: dto.MessageRequestInvalid.Text(),
+		}
+		resp.Errors, resp.Message = localizeErrors(ctx, resp.Errors, resp.Message)
+		return resp, nil
+	}
+
+	resp, err := c.compareAddressesUsecase.Execute(ctx, request)
+	if err != nil {
+		errResp := dto.NewCompareErrorResponse(err)
+		errResp.Errors, errResp.Message = localizeErrors(ctx, errResp.Errors, errResp.Message)
+		return errResp, nil
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestCompareAddressesHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestBody   string
+		setupMocks    func(*usecase.MockCompareAddressesUsecaseInterface)
+		checkResponse func(t *testing.T, resp *dto.CompareResponse)
+	}{
+		{
+			name:        "successful comparison",
+			requestBody: `{"address_a":"123 Main St, Springfield, IL 62701","address_b":"123 main street springfield il 62701","mode":"full"}`,
+			setupMocks: func(m *usecase.MockCompareAddressesUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), &dto.CompareRequest{
+						AddressA: "123 Main St, Springfield, IL 62701",
+						AddressB: "123 main street springfield il 62701",
+						Mode:     "full",
+					}).
+					Return(&dto.CompareResponse{Success: true, Verdict: dto.MatchExact, Similarity: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *dto.CompareResponse) {
+				assert.True(t, resp.Success)
+				assert.Equal(t, "exact", resp.Verdict)
+			},
+		},
+		{
+			name:        "invalid address",
+			requestBody: `{"address_a":"123 Main St","address_b":""}`,
+			setupMocks: func(m *usecase.MockCompareAddressesUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(nil, &domainerrors.ValidationError{Field: "address_b", Reason: "address field is required and cannot be empty"})
+			},
+			checkResponse: func(t *testing.T, resp *dto.CompareResponse) {
+				assert.False(t, resp.Success)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "address_b", resp.Errors[0].Field)
+			},
+		},
+		{
+			name:        "malformed body",
+			requestBody: `not json`,
+			checkResponse: func(t *testing.T, resp *dto.CompareResponse) {
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Request validation failed", resp.Message)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockCompareAddressesUsecaseInterface(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockUsecase)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/compare", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := &gofr.Context{
+				Context:   req.Context(),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := NewCompareAddressesHandler(mockUsecase).Handle(ctx)
+
+			require.NoError(t, err)
+			resp, ok := result.(*dto.CompareResponse)
+			require.True(t, ok)
+			tt.checkResponse(t, resp)
+		})
+	}
+}
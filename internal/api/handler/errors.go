@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// errorStatus returns the HTTP status and the gRPC code a usecase error is reported
+// with, so a failure means the same thing over either transport. A rejected request is
+// 400, and an address that cannot be normalized is 422; gRPC has no code for the latter,
+// so both are INVALID_ARGUMENT there, told apart by the error codes in the details.
+func errorStatus(err error) (int, codes.Code) {
+	var (
+		validationErr *domainerrors.ValidationError
+		parsingErr    *domainerrors.ParsingError
+		timeoutErr    *domainerrors.TimeoutError
+		notFoundErr   *domainerrors.NotFoundError
+	)
+	switch {
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest, codes.InvalidArgument
+	case errors.As(err, &parsingErr):
+		return http.StatusUnprocessableEntity, codes.InvalidArgument
+	case errors.As(err, &timeoutErr):
+		return http.StatusGatewayTimeout, codes.DeadlineExceeded
+	case errors.As(err, &notFoundErr):
+		return http.StatusNotFound, codes.NotFound
+	}
+	return http.StatusInternalServerError, codes.Internal
+}
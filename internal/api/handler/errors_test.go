@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{"rejected request", &domainerrors.ValidationError{Field: "address", Reason: "address is required"}, http.StatusBadRequest, codes.InvalidArgument},
+		{"unparseable address", &domainerrors.ParsingError{Field: "address", Reason: "could not parse"}, http.StatusUnprocessableEntity, codes.InvalidArgument},
+		{
+			"joined policy errors",
+			errors.Join(
+				&domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "state", Reason: "invalid state code: XX"},
+				&domainerrors.ParsingError{Code: domainerrors.CodeZIPFormat, Field: "postal_code", Reason: "postal_code must be 5 or 9-digit format"},
+			),
+			http.StatusUnprocessableEntity, codes.InvalidArgument,
+		},
+		{"timeout", &domainerrors.TimeoutError{Reason: "deadline exceeded"}, http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{"not found", fmt.Errorf("load: %w", &domainerrors.NotFoundError{Resource: "job", ID: "abc"}), http.StatusNotFound, codes.NotFound},
+		{"anything else", errors.New("boom"), http.StatusInternalServerError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpStatus, grpcCode := errorStatus(tt.err)
+
+			assert.Equal(t, tt.httpStatus, httpStatus)
+			assert.Equal(t, tt.grpcCode, grpcCode)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// ExtractAddressesHandler handles POST /api/v1/extract-addresses requests.
+type ExtractAddressesHandler struct {
+	extractAddressesUsecase usecase.ExtractAddressesUsecaseInterface
+}
+
+// NewExtractAddressesHandler creates a new ExtractAddressesHandler.
+func NewExtractAddressesHandler(extractAddressesUsecase usecase.ExtractAddressesUsecaseInterface) *ExtractAddressesHandler {
+	return &ExtractAddressesHandler{
+		extractAddressesUsecase: extractAddressesUsecase,
+	}
+}
+
+// Register registers the extract-addresses route with the GoFr app.
+func (e *ExtractAddressesHandler) Register(app *gofr.App) {
+	app.POST("/api/v1/extract-addresses", func(ctx *gofr.Context) (any, error) {
+		return e.Handle(ctx)
+	})
+}
+
+// Handle processes the extract-addresses request.
+func (e *ExtractAddressesHandler) Handle(ctx *gofr.Context) (any, error) {
+	request := new(dto.ExtractRequest)
+	if err := ctx.Bind(request); err != nil {
+		resp := &dto.ExtractResponse{
+			Success: false,
+			Errors: []dto.ErrorDTO{
+				{
+					Code:       string(domainerrors.CodeRequestMalformed),
+					DocsURL:    domainerrors.CodeRequestMalformed.DocsURL(),
+					Field:      "text",
+					Reason:     "Invalid request format",
+					Suggestion: "Provide a JSON body with a 'text' field",
+				},
+			},
+			Message: dto.MessageRequestInvalid.Text(),
+		}
+		resp.Errors, resp.Message = localizeErrors(ctx, resp.Errors, resp.Message)
+		return resp, nil
+	}
+
+	resp, err := e.extractAddressesUsecase.Execute(ctx, request)
+	if err != nil {
+		errResp := handleUsecaseError(err)
+		errs, message := localizeErrors(ctx, errResp.Errors, errResp.Message)
+		return &dto.ExtractResponse{
+			Success: false,
+			Errors:  errs,
+			Message: message,
+		}, nil
+	}
+
+	return responseLanguage(ctx).ExtractResponse(resp), nil
+}
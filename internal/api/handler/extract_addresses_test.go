@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestExtractAddressesHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		acceptLanguage string
+		setupMocks     func(*usecase.MockExtractAddressesUsecaseInterface)
+		checkResponse  func(t *testing.T, result any)
+	}{
+		{
+			name:        "successful extraction",
+			requestBody: `{"text":"Ship to 123 Main St, Springfield, IL 62701 please"}`,
+			setupMocks: func(mockUsecase *usecase.MockExtractAddressesUsecaseInterface) {
+				mockUsecase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(&dto.ExtractResponse{
+						Success: true,
+						Addresses: []*dto.ExtractedAddressDTO{
+							{
+								Text:       "123 Main St, Springfield, IL 62701",
+								Start:      8,
+								End:        42,
+								Confidence: 1,
+								Result:     &dto.ValidateResponse{Success: true},
+							},
+						},
+						Message: "Found 1 address",
+					}, nil).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.ExtractResponse)
+				require.True(t, ok)
+				assert.True(t, resp.Success)
+				require.Len(t, resp.Addresses, 1)
+				assert.Equal(t, 8, resp.Addresses[0].Start)
+			},
+		},
+		{
+			name:        "empty text returns validation error",
+			requestBody: `{"text":""}`,
+			setupMocks: func(mockUsecase *usecase.MockExtractAddressesUsecaseInterface) {
+				mockUsecase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(nil, &domainerrors.ValidationError{
+						Field:  "text",
+						Reason: "text field is required and cannot be empty",
+					}).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.ExtractResponse)
+				require.True(t, ok)
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Request validation failed", resp.Message)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "text", resp.Errors[0].Field)
+			},
+		},
+		{
+			name:           "nested results are in the request's language",
+			requestBody:    `{"text":"Ship to 123 Main St, springfield, IL 62701 please"}`,
+			acceptLanguage: "es",
+			setupMocks: func(mockUsecase *usecase.MockExtractAddressesUsecaseInterface) {
+				mockUsecase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(&dto.ExtractResponse{
+						Success: true,
+						Addresses: []*dto.ExtractedAddressDTO{{
+							Text: "123 Main St, springfield, IL 62701",
+							Result: &dto.ValidateResponse{
+								Success:     true,
+								Corrections: []*dto.CorrectionDTO{{Component: "city", Original: "springfield", Corrected: "Springfield", Type: "capitalization"}},
+								Warnings:    []dto.WarningDTO{{Code: "COMPONENT_CORRECTED", Field: "city", Message: "city was changed from springfield to Springfield"}},
+								Message:     "Address validated successfully",
+							},
+						}},
+						Message: "Found 1 address",
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.ExtractResponse)
+				require.True(t, ok)
+				assert.Equal(t, "Se encontró 1 dirección", resp.Message)
+				assert.Equal(t, "Dirección validada correctamente", resp.Addresses[0].Result.Message)
+				assert.Equal(t, "city se cambió de springfield a Springfield", resp.Addresses[0].Result.Warnings[0].Message)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := usecase.NewMockExtractAddressesUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			handler := NewExtractAddressesHandler(mockUsecase)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/extract-addresses",
+				bytes.NewBuffer([]byte(tt.requestBody)),
+			)
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := &gofr.Context{
+				Context:   middleware.ContextWithAcceptLanguage(req.Context(), tt.acceptLanguage),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := handler.Handle(ctx)
+
+			require.NoError(t, err)
+			tt.checkResponse(t, result)
+		})
+	}
+}
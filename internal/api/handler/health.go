@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// HealthHandler handles the GET /health liveness and GET /ready readiness probes. They
+// are plain http.Handlers so readiness can answer 503 while the service is not ready.
+type HealthHandler struct {
+	healthUsecase usecase.HealthUsecaseInterface
+}
+
+// NewHealthHandler creates a new HealthHandler.
+func NewHealthHandler(healthUsecase usecase.HealthUsecaseInterface) *HealthHandler {
+	return &HealthHandler{
+		healthUsecase: healthUsecase,
+	}
+}
+
+// Register mounts the probes on the GoFr app.
+func (h *HealthHandler) Register(app *gofr.App) {
+	app.UseMiddleware(middleware.Route(http.MethodGet, "/health", http.HandlerFunc(h.Live)))
+	app.UseMiddleware(middleware.Route(http.MethodGet, "/ready", http.HandlerFunc(h.Ready)))
+}
+
+// Live answers 200 while the process is running.
+func (h *HealthHandler) Live(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.healthUsecase.Live(r.Context()))
+}
+
+// Ready answers 200 once every readiness check is up and 503 otherwise, with each
+// check's outcome in the body either way.
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	resp := h.healthUsecase.Ready(r.Context())
+
+	status := http.StatusOK
+	if !resp.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, resp)
+}
+
+// writeJSON writes data the way GoFr writes handler responses, with the given status.
+func writeJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestHealthHandler_Live(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := usecase.NewMockHealthUsecaseInterface(ctrl)
+	mockUsecase.EXPECT().Live(gomock.Any()).Return(&dto.HealthResponse{Status: dto.HealthStatusUp, Version: "0.1.0"})
+
+	rec := httptest.NewRecorder()
+	NewHealthHandler(mockUsecase).Live(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"data":{"status":"up","version":"0.1.0","timestamp":"0001-01-01T00:00:00Z"}}`, rec.Body.String())
+}
+
+func TestHealthHandler_Ready(t *testing.T) {
+	tests := []struct {
+		name         string
+		resp         *dto.ReadinessResponse
+		expectStatus int
+	}{
+		{
+			name: "ready",
+			resp: &dto.ReadinessResponse{Ready: true, Status: dto.HealthStatusUp, Checks: map[string]*dto.HealthCheckDTO{
+				"parser": {Status: dto.HealthStatusUp},
+			}},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "not ready",
+			resp: &dto.ReadinessResponse{Status: dto.HealthStatusDown, Checks: map[string]*dto.HealthCheckDTO{
+				"parser": {Status: dto.HealthStatusDown, Error: usecase.ErrWarmingUp.Error()},
+			}},
+			expectStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockHealthUsecaseInterface(ctrl)
+			mockUsecase.EXPECT().Ready(gomock.Any()).Return(tt.resp)
+
+			rec := httptest.NewRecorder()
+			NewHealthHandler(mockUsecase).Ready(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			assert.Equal(t, tt.expectStatus, rec.Code)
+
+			var body struct {
+				Data *dto.ReadinessResponse `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.resp.Ready, body.Data.Ready)
+			assert.Equal(t, tt.resp.Checks["parser"], body.Data.Checks["parser"])
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// JobsHandler handles the /api/v1/jobs endpoints.
+type JobsHandler struct {
+	jobsUsecase usecase.JobsUsecaseInterface
+}
+
+// NewJobsHandler creates a new JobsHandler.
+func NewJobsHandler(jobsUsecase usecase.JobsUsecaseInterface) *JobsHandler {
+	return &JobsHandler{
+		jobsUsecase: jobsUsecase,
+	}
+}
+
+// Register registers the job routes with the GoFr app.
+func (j *JobsHandler) Register(app *gofr.App) {
+	app.POST("/api/v1/jobs", func(ctx *gofr.Context) (any, error) {
+		return j.HandleCreate(ctx)
+	})
+	app.GET("/api/v1/jobs/{id}", func(ctx *gofr.Context) (any, error) {
+		return j.HandleGet(ctx)
+	})
+}
+
+// HandleCreate processes a job submission.
+func (j *JobsHandler) HandleCreate(ctx *gofr.Context) (any, error) {
+	request := new(dto.CreateJobRequest)
+	if err := ctx.Bind(request); err != nil {
+		resp := &dto.JobResponse{
+			Success: false,
+			Errors: []dto.ErrorDTO{
+				{
+					Code:       string(domainerrors.CodeRequestMalformed),
+					DocsURL:    domainerrors.CodeRequestMalformed.DocsURL(),
+					Field:      "addresses",
+					Reason:     "Invalid request format",
+					Suggestion: "Provide a JSON body with an 'addresses' list",
+				},
+			},
+			Message: dto.MessageRequestInvalid.Text(),
+		}
+		return responseLanguage(ctx).JobResponse(resp), nil
+	}
+
+	resp, err := j.jobsUsecase.Submit(ctx, request)
+	if err != nil {
+		return responseLanguage(ctx).JobResponse(dto.NewJobErrorResponse(err)), nil
+	}
+
+	return responseLanguage(ctx).JobResponse(resp), nil
+}
+
+// HandleGet returns a job's state and a page of its results.
+func (j *JobsHandler) HandleGet(ctx *gofr.Context) (any, error) {
+	lang := responseLanguage(ctx)
+
+	page, err := intParam(ctx, "page")
+	if err != nil {
+		return lang.JobResponse(dto.NewJobErrorResponse(err)), nil
+	}
+
+	pageSize, err := intParam(ctx, "page_size")
+	if err != nil {
+		return lang.JobResponse(dto.NewJobErrorResponse(err)), nil
+	}
+
+	resp, err := j.jobsUsecase.Get(ctx, ctx.PathParam("id"), page, pageSize)
+	if err != nil {
+		return lang.JobResponse(dto.NewJobErrorResponse(err)), nil
+	}
+
+	return lang.JobResponse(resp), nil
+}
+
+// intParam reads an optional integer query parameter; absent parameters are zero.
+func intParam(ctx *gofr.Context, name string) (int, error) {
+	value := ctx.Param(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      name,
+			Reason:     name + " must be an integer",
+			Value:      value,
+			Suggestion: "Omit " + name + " to use the default",
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestJobsHandler_HandleCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		acceptLanguage string
+		setupMocks     func(*usecase.MockJobsUsecaseInterface)
+		checkResponse  func(t *testing.T, result any)
+	}{
+		{
+			name:        "job is queued",
+			requestBody: `{"addresses":["123 Main St, Springfield, IL 62701"],"mode":"full"}`,
+			setupMocks: func(m *usecase.MockJobsUsecaseInterface) {
+				m.EXPECT().
+					Submit(gomock.Any(), &dto.CreateJobRequest{
+						Addresses: []string{"123 Main St, Springfield, IL 62701"},
+						Mode:      "full",
+					}).
+					Return(&dto.JobResponse{
+						Success: true,
+						Job:     &dto.JobDTO{ID: "abc", Status: "queued", Total: 1},
+						Message: "Job queued",
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.True(t, resp.Success)
+				assert.Equal(t, "abc", resp.Job.ID)
+			},
+		},
+		{
+			name:        "malformed body",
+			requestBody: `{"addresses":`,
+			setupMocks:  func(*usecase.MockJobsUsecaseInterface) {},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.False(t, resp.Success)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "addresses", resp.Errors[0].Field)
+			},
+		},
+		{
+			name:        "usecase validation error",
+			requestBody: `{"addresses":[]}`,
+			setupMocks: func(m *usecase.MockJobsUsecaseInterface) {
+				m.EXPECT().
+					Submit(gomock.Any(), gomock.Any()).
+					Return(nil, &domainerrors.ValidationError{Field: "addresses", Reason: "addresses field is required and cannot be empty"})
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Request validation failed", resp.Message)
+			},
+		},
+		{
+			name:           "errors are in the request's language",
+			requestBody:    `{"addresses":`,
+			acceptLanguage: "es",
+			setupMocks:     func(*usecase.MockJobsUsecaseInterface) {},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.Equal(t, "La solicitud no es válida", resp.Message)
+				assert.Equal(t, "No se pudo leer la solicitud", resp.Errors[0].Reason)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockJobsUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/jobs", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := &gofr.Context{
+				Context:   middleware.ContextWithAcceptLanguage(req.Context(), tt.acceptLanguage),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := NewJobsHandler(mockUsecase).HandleCreate(ctx)
+
+			require.NoError(t, err)
+			tt.checkResponse(t, result)
+		})
+	}
+}
+
+func TestJobsHandler_HandleGet(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		setupMocks     func(*usecase.MockJobsUsecaseInterface)
+		checkResponse  func(t *testing.T, result any)
+	}{
+		{
+			name:   "passes id and pagination",
+			target: "/api/v1/jobs/abc?page=2&page_size=50",
+			setupMocks: func(m *usecase.MockJobsUsecaseInterface) {
+				m.EXPECT().
+					Get(gomock.Any(), "abc", 2, 50).
+					Return(&dto.JobResponse{Success: true, Job: &dto.JobDTO{ID: "abc", Status: "running"}}, nil)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.Equal(t, "running", resp.Job.Status)
+			},
+		},
+		{
+			name:       "non-numeric page",
+			target:     "/api/v1/jobs/abc?page=two",
+			setupMocks: func(*usecase.MockJobsUsecaseInterface) {},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.False(t, resp.Success)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "page", resp.Errors[0].Field)
+			},
+		},
+		{
+			name:   "unknown job",
+			target: "/api/v1/jobs/abc",
+			setupMocks: func(m *usecase.MockJobsUsecaseInterface) {
+				m.EXPECT().
+					Get(gomock.Any(), "abc", 0, 0).
+					Return(nil, &domainerrors.NotFoundError{Resource: "job", ID: "abc"})
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Resource not found", resp.Message)
+			},
+		},
+		{
+			name:           "item results are in the request's language",
+			target:         "/api/v1/jobs/abc",
+			acceptLanguage: "es",
+			setupMocks: func(m *usecase.MockJobsUsecaseInterface) {
+				m.EXPECT().
+					Get(gomock.Any(), "abc", 0, 0).
+					Return(&dto.JobResponse{
+						Success: true,
+						Job:     &dto.JobDTO{ID: "abc", Status: "done"},
+						Results: []*dto.JobResultDTO{{Index: 0, Result: &dto.ValidateResponse{
+							Errors:  []dto.ErrorDTO{{Code: string(domainerrors.CodeAddressEmpty), Field: "address", Reason: "address field is required and cannot be empty"}},
+							Message: "Request validation failed",
+						}}},
+						Message: "Job done",
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.JobResponse)
+				require.True(t, ok)
+				assert.Equal(t, "Trabajo terminado", resp.Message)
+				assert.Equal(t, "La solicitud no es válida", resp.Results[0].Result.Message)
+				assert.Equal(t, "La dirección es obligatoria y no puede estar vacía", resp.Results[0].Result.Errors[0].Reason)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockJobsUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "abc"})
+
+			ctx := &gofr.Context{
+				Context:   middleware.ContextWithAcceptLanguage(req.Context(), tt.acceptLanguage),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := NewJobsHandler(mockUsecase).HandleGet(ctx)
+
+			require.NoError(t, err)
+			tt.checkResponse(t, result)
+		})
+	}
+}
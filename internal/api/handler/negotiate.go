@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"mime"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+)
+
+// Media types a validation response can be rendered as. JSON comes first, so it
+// is what clients get when they accept anything or nothing we offer.
+const (
+	mediaTypeJSON    = "application/json"
+	mediaTypeXML     = "application/xml"
+	mediaTypeTextXML = "text/xml"
+	mediaTypeVCard   = "text/vcard"
+	mediaTypeJCard   = "application/vcard+json"
+)
+
+var validateResponseMediaTypes = []string{
+	mediaTypeJSON, mediaTypeXML, mediaTypeTextXML, mediaTypeVCard, mediaTypeJCard,
+}
+
+// renderValidateResponse returns resp in the media type the request's Accept header
+// prefers, with its messages in the language Accept-Language prefers. JSON is
+// returned as the DTO for GoFr to encode; other types as a file with its content
+// type. vCard describes an address, so responses without one, such as errors, fall
+// back to JSON. GeoJSON is not offered: without geocoding there is no geometry.
+func renderValidateResponse(ctx *gofr.Context, resp *dto.ValidateResponse) (any, error) {
+	resp = responseLanguage(ctx).ValidateResponse(resp)
+	mediaType := negotiate(middleware.AcceptFromContext(ctx), validateResponseMediaTypes)
+
+	var (
+		content []byte
+		err     error
+	)
+	switch {
+	case mediaType == mediaTypeXML || mediaType == mediaTypeTextXML:
+		content, err = xml.Marshal(resp)
+		content = append([]byte(xml.Header), content...)
+	case resp.Address == nil || mediaType == mediaTypeJSON:
+		return resp, nil
+	case mediaType == mediaTypeVCard:
+		content = []byte(dto.NewVCard(resp.Address))
+	case mediaType == mediaTypeJCard:
+		content, err = json.Marshal(dto.NewJCard(resp.Address))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if mediaType == mediaTypeVCard || mediaType == mediaTypeTextXML {
+		mediaType += "; charset=utf-8"
+	}
+	return response.File{Content: content, ContentType: mediaType}, nil
+}
+
+// responseLanguage returns the language the request's Accept-Language header prefers.
+func responseLanguage(ctx context.Context) i18n.Language {
+	return i18n.Negotiate(middleware.AcceptLanguageFromContext(ctx))
+}
+
+// localizeErrors returns an error response's errors and message in the request's language.
+func localizeErrors(ctx context.Context, errs []dto.ErrorDTO, message string) ([]dto.ErrorDTO, string) {
+	lang := responseLanguage(ctx)
+	return lang.Errors(errs), lang.Message(message)
+}
+
+// negotiate returns the offer the Accept header ranks highest. Each offer takes the
+// quality of the most specific media range matching it; ties go to the earlier
+// offer. An empty or unparsable header, or one accepting no offer, gets the first.
+func negotiate(accept string, offers []string) string {
+	best, bestQuality := offers[0], 0.0
+	for _, offer := range offers {
+		if quality := acceptQuality(accept, offer); quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best
+}
+
+// acceptQuality returns the q value the Accept header gives offer, 0 when it is not accepted.
+func acceptQuality(accept, offer string) float64 {
+	offerType, _, _ := strings.Cut(offer, "/")
+
+	quality, specificity := 0.0, -1
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		var rank int
+		switch {
+		case mediaType == offer:
+			rank = 2
+		case mediaType == offerType+"/*":
+			rank = 1
+		case mediaType == "*/*":
+			rank = 0
+		default:
+			continue
+		}
+		if rank <= specificity {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				q = 0
+			}
+		}
+		quality, specificity = q, rank
+	}
+	return quality
+}
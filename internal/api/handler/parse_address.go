@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// ParseAddressHandler handles POST /api/v1/parse requests. It exposes parser internals,
+// so it is only registered when admin endpoints are enabled, and every request must
+// carry the admin token.
+type ParseAddressHandler struct {
+	parseAddressUsecase usecase.ParseAddressUsecaseInterface
+	adminToken          string
+}
+
+// NewParseAddressHandler creates a new ParseAddressHandler that serves requests bearing adminToken.
+func NewParseAddressHandler(parseAddressUsecase usecase.ParseAddressUsecaseInterface, adminToken string) *ParseAddressHandler {
+	return &ParseAddressHandler{
+		parseAddressUsecase: parseAddressUsecase,
+		adminToken:          adminToken,
+	}
+}
+
+// Register registers the parse route, behind the admin token check, with the GoFr app.
+func (p *ParseAddressHandler) Register(app *gofr.App) {
+	app.UseMiddleware(middleware.AdminToken(p.adminToken, "/api/v1/parse"))
+	app.POST("/api/v1/parse", func(ctx *gofr.Context) (any, error) {
+		return p.Handle(ctx)
+	})
+}
+
+// Handle processes the parse request.
+func (p *ParseAddressHandler) Handle(ctx *gofr.Context) (any, error) {
+	request := new(dto.ParseRequest)
+	if err := ctx.Bind(request); err != nil {
+		resp := &dto.ParseResponse{
+			Success: false,
+			Errors: []dto.ErrorDTO{
+				{
+					Code:       string(domainerrors.CodeRequestMalformed),
+					DocsURL:    domainerrors.CodeRequestMalformed.DocsURL(),
+					Field:      "address",
+					Reason:     "Invalid request format",
+					Suggestion: "Provide a JSON body with an 'address' field",
+				},
+			},
+			Message: dto.MessageRequestInvalid.Text(),
+		}
+		resp.Errors, resp.Message = localizeErrors(ctx, resp.Errors, resp.Message)
+		return resp, nil
+	}
+
+	resp, err := p.parseAddressUsecase.Execute(ctx, request)
+	if err != nil {
+		errResp := dto.NewParseErrorResponse(err)
+		errResp.Errors, errResp.Message = localizeErrors(ctx, errResp.Errors, errResp.Message)
+		return errResp, nil
+	}
+
+	return resp, nil
+}
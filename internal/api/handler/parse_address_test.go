@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestParseAddressHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestBody   string
+		setupMocks    func(*usecase.MockParseAddressUsecaseInterface)
+		checkResponse func(t *testing.T, resp *dto.ParseResponse)
+	}{
+		{
+			name:        "successful parse",
+			requestBody: `{"address":"123 main st, springfield, il"}`,
+			setupMocks: func(m *usecase.MockParseAddressUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), &dto.ParseRequest{Address: "123 main st, springfield, il"}).
+					Return(&dto.ParseResponse{
+						Success:    true,
+						Parser:     "regex/1",
+						Labels:     []*dto.ParseLabelDTO{{Label: "street", Value: "123 main st"}},
+						Normalized: map[string]string{"street_address": "123 Main St"},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *dto.ParseResponse) {
+				assert.True(t, resp.Success)
+				assert.Equal(t, "regex/1", resp.Parser)
+				assert.Equal(t, "street", resp.Labels[0].Label)
+				assert.Equal(t, "123 Main St", resp.Normalized["street_address"])
+			},
+		},
+		{
+			name:        "empty address",
+			requestBody: `{"address":""}`,
+			setupMocks: func(m *usecase.MockParseAddressUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(nil, &domainerrors.ValidationError{Field: "address", Reason: "address field is required and cannot be empty"})
+			},
+			checkResponse: func(t *testing.T, resp *dto.ParseResponse) {
+				assert.False(t, resp.Success)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "address", resp.Errors[0].Field)
+			},
+		},
+		{
+			name:        "malformed body",
+			requestBody: `not json`,
+			checkResponse: func(t *testing.T, resp *dto.ParseResponse) {
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Request validation failed", resp.Message)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockParseAddressUsecaseInterface(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockUsecase)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/parse", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := &gofr.Context{
+				Context:   req.Context(),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := NewParseAddressHandler(mockUsecase, "s3cret").Handle(ctx)
+
+			require.NoError(t, err)
+			resp, ok := result.(*dto.ParseResponse)
+			require.True(t, ok)
+			tt.checkResponse(t, resp)
+		})
+	}
+}
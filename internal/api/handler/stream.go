@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+)
+
+// streamResponseWriter sends its headers on the first write and flushes every write,
+// so a streaming handler's output reaches the client as it is produced.
+type streamResponseWriter struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	header  map[string]string
+	started bool
+}
+
+// newStreamResponseWriter prepares w for a response that is written while the request
+// body is still being read. HTTP/1 servers otherwise stop reading the body once the
+// response starts; HTTP/2 always allows it, so enabling full duplex may fail harmlessly.
+func newStreamResponseWriter(w http.ResponseWriter, header map[string]string) *streamResponseWriter {
+	rc := http.NewResponseController(w)
+	_ = rc.EnableFullDuplex()
+	return &streamResponseWriter{w: w, rc: rc, header: header}
+}
+
+func (s *streamResponseWriter) Write(p []byte) (int, error) {
+	s.start()
+
+	n, err := s.w.Write(p)
+	if err != nil {
+		return n, err
+	}
+	_ = s.rc.Flush()
+	return n, nil
+}
+
+// start sends the status line and headers unless they were already sent.
+func (s *streamResponseWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	for key, value := range s.header {
+		s.w.Header().Set(key, value)
+	}
+	s.w.WriteHeader(http.StatusOK)
+}
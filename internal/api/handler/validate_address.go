@@ -1,8 +1,6 @@
 package handler
 
 import (
-	"errors"
-
 	"gofr.dev/pkg/gofr"
 
 	"github.com/williandandrade/address-validation-service/internal/api/dto"
@@ -29,66 +27,35 @@ func (v *ValidateAddressHandler) Register(app *gofr.App) {
 	})
 }
 
-// Handle processes the validate-address request.
+// Handle processes the validate-address request. The response is rendered in the
+// media type negotiated from the Accept header.
 func (v *ValidateAddressHandler) Handle(ctx *gofr.Context) (any, error) {
 	request := new(dto.ValidateRequest)
 	if err := ctx.Bind(request); err != nil {
-		return &dto.ValidateResponse{
+		return renderValidateResponse(ctx, &dto.ValidateResponse{
 			Success: false,
 			Errors: []dto.ErrorDTO{
 				{
+					Code:       string(domainerrors.CodeRequestMalformed),
+					DocsURL:    domainerrors.CodeRequestMalformed.DocsURL(),
 					Field:      "address",
 					Reason:     "Invalid request format",
 					Suggestion: "Provide a JSON body with an 'address' field",
 				},
 			},
-			Message: "Request validation failed",
-		}, nil
+			MessageCode: dto.MessageRequestInvalid,
+			Message:     dto.MessageRequestInvalid.Text(),
+		})
 	}
 
 	resp, err := v.validateAddressUsecase.Execute(ctx, request)
 	if err != nil {
-		return handleUsecaseError(err), nil
+		return renderValidateResponse(ctx, handleUsecaseError(err))
 	}
 
-	return resp, nil
+	return renderValidateResponse(ctx, resp)
 }
 
 func handleUsecaseError(err error) *dto.ValidateResponse {
-	var validationErr *domainerrors.ValidationError
-	if errors.As(err, &validationErr) {
-		return &dto.ValidateResponse{
-			Success: false,
-			Errors: []dto.ErrorDTO{
-				{
-					Field:      validationErr.Field,
-					Reason:     validationErr.Reason,
-					Value:      validationErr.Value,
-					Suggestion: validationErr.Suggestion,
-				},
-			},
-			Message: "Request validation failed",
-		}
-	}
-
-	var parsingErr *domainerrors.ParsingError
-	if errors.As(err, &parsingErr) {
-		return &dto.ValidateResponse{
-			Success: false,
-			Errors: []dto.ErrorDTO{
-				{
-					Field:      parsingErr.Field,
-					Reason:     parsingErr.Reason,
-					Value:      parsingErr.Value,
-					Suggestion: parsingErr.Suggestion,
-				},
-			},
-			Message: "Address could not be normalized",
-		}
-	}
-
-	return &dto.ValidateResponse{
-		Success: false,
-		Message: "Internal server error",
-	}
+	return dto.NewErrorResponse(err)
 }
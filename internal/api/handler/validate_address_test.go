@@ -2,6 +2,7 @@ package handler
 
 import (
 	"bytes"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,8 +12,10 @@ import (
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
 	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
 
 	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
 	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
 	"github.com/williandandrade/address-validation-service/internal/usecase"
 )
@@ -100,6 +103,29 @@ func TestValidateAddressHandler_Handle(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name:        "every policy problem is listed",
+			requestBody: `{"address":"123 Main St, Faketown, XX 123"}`,
+			setupMocks: func(mockUsecase *usecase.MockValidateAddressUsecaseInterface) {
+				mockUsecase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Join(
+						&domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "address", Reason: "invalid state code: XX", Value: "XX"},
+						&domainerrors.ParsingError{Code: domainerrors.CodeZIPFormat, Field: "address", Reason: "postal_code must be 5 or 9-digit format", Value: "123"},
+					)).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, result any) {
+				resp, ok := result.(*dto.ValidateResponse)
+				require.True(t, ok)
+				assert.Equal(t, "Address could not be normalized", resp.Message)
+				require.Len(t, resp.Errors, 2)
+				assert.Equal(t, "STATE_INVALID", resp.Errors[0].Code)
+				assert.Equal(t, "ZIP_FORMAT", resp.Errors[1].Code)
+				assert.Equal(t, "123", resp.Errors[1].Value)
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -138,3 +164,201 @@ func TestValidateAddressHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAddressHandler_Handle_ContentNegotiation(t *testing.T) {
+	validated := &dto.ValidateResponse{
+		Success: true,
+		Status:  dto.StatusValid,
+		Address: &dto.AddressDTO{
+			StreetAddress:    "123 Main St",
+			City:             "New York",
+			State:            "NY",
+			PostalCode:       "10001",
+			FormattedAddress: "123 Main St, New York, NY 10001",
+		},
+		Components: map[string]*dto.ComponentDTO{
+			"state": {Value: "NY", Raw: "ny", Start: 23, End: 25},
+			"city":  {Value: "New York", Raw: "new york", Start: 13, End: 21},
+		},
+		Message: "Address validated successfully",
+	}
+
+	tests := []struct {
+		name        string
+		accept      string
+		response    *dto.ValidateResponse
+		contentType string
+		contains    []string
+	}{
+		{
+			name:        "xml",
+			accept:      "application/xml",
+			response:    validated,
+			contentType: "application/xml",
+			contains: []string{
+				`<?xml version="1.0" encoding="UTF-8"?>`,
+				"<validate_response><success>true</success><status>valid</status>",
+				"<street_address>123 Main St</street_address>",
+				`<components><component name="city"><value>New York</value>`,
+			},
+		},
+		{
+			name:        "vcard",
+			accept:      "text/vcard",
+			response:    validated,
+			contentType: "text/vcard; charset=utf-8",
+			contains:    []string{"BEGIN:VCARD\r\nVERSION:4.0\r\nKIND:location\r\n", "FN:123 Main St\\, New York\\, NY 10001\r\n", "ADR:;;123 Main St;New York;NY;10001;USA\r\n"},
+		},
+		{
+			name:        "jcard",
+			accept:      "application/vcard+json",
+			response:    validated,
+			contentType: "application/vcard+json",
+			contains:    []string{`["adr",{},"text",["","","123 Main St","New York","NY","10001","USA"]]`},
+		},
+		{
+			name:        "errors are rendered as xml",
+			accept:      "text/xml",
+			response:    &dto.ValidateResponse{Errors: []dto.ErrorDTO{{Code: "FIELD_REQUIRED", Field: "address", Reason: "required"}}, Message: "Request validation failed"},
+			contentType: "text/xml; charset=utf-8",
+			contains:    []string{"<errors><error><code>FIELD_REQUIRED</code><docs_url></docs_url><field>address</field><reason>required</reason></error></errors>"},
+		},
+		{
+			name:        "empty lists are left out of xml",
+			accept:      "application/xml",
+			response:    &dto.ValidateResponse{Success: true, Message: "ok"},
+			contentType: "application/xml",
+			contains:    []string{"<validate_response><success>true</success><message>ok</message></validate_response>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockValidateAddressUsecaseInterface(ctrl)
+			mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(tt.response, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/validate-address", bytes.NewBufferString(`{"address":"123 main st, new york, ny 10001"}`))
+			ctx := &gofr.Context{
+				Context:   middleware.ContextWithAccept(req.Context(), tt.accept),
+				Request:   gofrHttp.NewRequest(req),
+				Container: nil,
+			}
+
+			result, err := NewValidateAddressHandler(mockUsecase).Handle(ctx)
+
+			require.NoError(t, err)
+			file, ok := result.(response.File)
+			require.True(t, ok)
+			assert.Equal(t, tt.contentType, file.ContentType)
+			for _, want := range tt.contains {
+				assert.Contains(t, string(file.Content), want)
+			}
+		})
+	}
+
+	t.Run("an error without an address falls back to json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := usecase.NewMockValidateAddressUsecaseInterface(ctrl)
+		mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, &domainerrors.ValidationError{Field: "address", Reason: "required"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/validate-address", bytes.NewBufferString(`{"address":""}`))
+		ctx := &gofr.Context{
+			Context: middleware.ContextWithAccept(req.Context(), "text/vcard"),
+			Request: gofrHttp.NewRequest(req),
+		}
+
+		result, err := NewValidateAddressHandler(mockUsecase).Handle(ctx)
+
+		require.NoError(t, err)
+		assert.IsType(t, &dto.ValidateResponse{}, result)
+	})
+}
+
+func TestValidateAddressHandler_Handle_Localization(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		usecaseErr     error
+		expectMessage  string
+		expectReason   string
+	}{
+		{
+			name:           "spanish error",
+			acceptLanguage: "es-MX,es;q=0.9,en;q=0.5",
+			usecaseErr:     &domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "address", Reason: "invalid state code: XX", Value: "XX"},
+			expectMessage:  "No se pudo normalizar la dirección",
+			expectReason:   "Código de estado no válido: XX",
+		},
+		{
+			name:           "unsupported language falls back to english",
+			acceptLanguage: "fr",
+			usecaseErr:     &domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "address", Reason: "invalid state code: XX", Value: "XX"},
+			expectMessage:  "Address could not be normalized",
+			expectReason:   "invalid state code: XX",
+		},
+		{
+			name:           "spanish success",
+			acceptLanguage: "es",
+			expectMessage:  "Dirección validada correctamente",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockValidateAddressUsecaseInterface(ctrl)
+			cached := &dto.ValidateResponse{Success: true, Message: "Address validated successfully"}
+			if tt.usecaseErr != nil {
+				mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, tt.usecaseErr)
+			} else {
+				mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(cached, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/validate-address", bytes.NewBufferString(`{"address":"123 Main St, Faketown, XX"}`))
+			ctx := &gofr.Context{
+				Context: middleware.ContextWithAcceptLanguage(req.Context(), tt.acceptLanguage),
+				Request: gofrHttp.NewRequest(req),
+			}
+
+			result, err := NewValidateAddressHandler(mockUsecase).Handle(ctx)
+
+			require.NoError(t, err)
+			resp, ok := result.(*dto.ValidateResponse)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectMessage, resp.Message)
+			if tt.expectReason != "" {
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, tt.expectReason, resp.Errors[0].Reason)
+			}
+			// The usecase's response may be cached and shared, so it stays in English.
+			assert.Equal(t, "Address validated successfully", cached.Message)
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", mediaTypeJSON},
+		{"*/*", mediaTypeJSON},
+		{"text/html", mediaTypeJSON},
+		{"application/*", mediaTypeJSON},
+		{"application/xml", mediaTypeXML},
+		{"text/*", mediaTypeTextXML},
+		{"application/json;q=0.5, text/vcard", mediaTypeVCard},
+		{"application/geo+json;q=0.9, application/xml;q=0.9", mediaTypeXML},
+		{"application/geo+json", mediaTypeJSON},
+		{"*/*;q=0.1, application/vcard+json", mediaTypeJCard},
+		{"application/xml;q=0, */*", mediaTypeJSON},
+		{"application/xml;q=oops", mediaTypeJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			assert.Equal(t, tt.want, negotiate(tt.accept, validateResponseMediaTypes))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// csvFormFieldLimit bounds each non-file form field; they only carry column names and options.
+const csvFormFieldLimit = 1 << 10
+
+// errorLogger is the part of GoFr's logger the streaming handlers need.
+type errorLogger interface {
+	Errorf(format string, args ...any)
+}
+
+// ValidateCSVHandler handles the POST /api/v1/validate-csv endpoint. It is a plain
+// http.Handler so the upload is read and the result written while rows are validated.
+type ValidateCSVHandler struct {
+	validateCSVUsecase usecase.ValidateCSVUsecaseInterface
+	logger             errorLogger
+}
+
+// NewValidateCSVHandler creates a new ValidateCSVHandler.
+func NewValidateCSVHandler(validateCSVUsecase usecase.ValidateCSVUsecaseInterface) *ValidateCSVHandler {
+	return &ValidateCSVHandler{
+		validateCSVUsecase: validateCSVUsecase,
+	}
+}
+
+// Register mounts the handler on the GoFr app.
+func (h *ValidateCSVHandler) Register(app *gofr.App) {
+	h.logger = app.Logger()
+	app.UseMiddleware(middleware.Route(http.MethodPost, "/api/v1/validate-csv", h))
+}
+
+// ServeHTTP reads the column mapping and the "file" part of a multipart upload and streams
+// back the annotated CSV. Mapping fields may be sent as form fields before the file or as
+// query parameters. Errors found before the first row is written are returned as JSON.
+func (h *ValidateCSVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, file, err := readCSVUpload(r)
+	if err != nil {
+		writeJSONError(w, r, err)
+		return
+	}
+
+	out := newStreamResponseWriter(w, map[string]string{
+		"Content-Type":        "text/csv; charset=utf-8",
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": csvResultFilename(file.FileName())}),
+	})
+	rows, err := h.validateCSVUsecase.Execute(r.Context(), file, out, request, responseLanguage(r.Context()))
+	if err == nil {
+		return
+	}
+	if !out.started {
+		writeJSONError(w, r, err)
+		return
+	}
+
+	// The status line is already sent; the truncated file is all the client gets.
+	if h.logger != nil {
+		h.logger.Errorf("CSV validation stopped after %d rows: %v", rows, err)
+	}
+}
+
+// readCSVUpload returns the mapping and the file part, leaving the file unread.
+func readCSVUpload(r *http.Request) (*dto.ValidateCSVRequest, *multipart.Part, error) {
+	query := r.URL.Query()
+	fields := map[string]string{}
+	for _, name := range csvFormFields {
+		fields[name] = query.Get(name)
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeRequestMalformed,
+			Field:      "file",
+			Reason:     "request must be multipart/form-data",
+			Suggestion: "Upload the CSV as the 'file' field of a multipart form",
+		}
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, nil, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeFieldRequired,
+				Field:      "file",
+				Reason:     "file field is required",
+				Suggestion: "Upload the CSV as the 'file' field of a multipart form, after the mapping fields",
+			}
+		}
+		if err != nil {
+			return nil, nil, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeRequestMalformed,
+				Field:      "file",
+				Reason:     "malformed multipart body: " + err.Error(),
+				Suggestion: "Upload the CSV as the 'file' field of a multipart form",
+			}
+		}
+
+		name := part.FormName()
+		if name == "file" {
+			request, err := newValidateCSVRequest(fields)
+			return request, part, err
+		}
+
+		value, err := io.ReadAll(io.LimitReader(part, csvFormFieldLimit))
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := fields[name]; ok {
+			fields[name] = strings.TrimSpace(string(value))
+		}
+	}
+}
+
+var csvFormFields = []string{
+	"address_column", "street_column", "street2_column", "city_column", "state_column", "postal_code_column",
+	"min_confidence", "mode", "strictness",
+}
+
+func newValidateCSVRequest(fields map[string]string) (*dto.ValidateCSVRequest, error) {
+	request := &dto.ValidateCSVRequest{
+		AddressColumn:    fields["address_column"],
+		StreetColumn:     fields["street_column"],
+		Street2Column:    fields["street2_column"],
+		CityColumn:       fields["city_column"],
+		StateColumn:      fields["state_column"],
+		PostalCodeColumn: fields["postal_code_column"],
+		Mode:             fields["mode"],
+		Strictness:       fields["strictness"],
+	}
+
+	if value := fields["min_confidence"]; value != "" {
+		minConfidence, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeFieldInvalid,
+				Field:      "min_confidence",
+				Reason:     "min_confidence must be a number",
+				Value:      value,
+				Suggestion: "Use a value between 0 and 1, e.g. 0.8",
+			}
+		}
+		request.MinConfidence = minConfidence
+	}
+	return request, nil
+}
+
+// csvResultFilename names the download after the upload, e.g. "customers-validated.csv".
+func csvResultFilename(upload string) string {
+	base := strings.TrimSuffix(filepath.Base(upload), filepath.Ext(upload))
+	if base == "" || base == "." || base == string(filepath.Separator) {
+		base = "addresses"
+	}
+	return base + "-validated.csv"
+}
+
+// writeJSONError writes err the way GoFr writes handler responses, with a status code
+// that matches the error and its messages in the language r prefers.
+func writeJSONError(w http.ResponseWriter, r *http.Request, err error) {
+	status, _ := errorStatus(err)
+	writeJSON(w, status, responseLanguage(r.Context()).ValidateResponse(dto.NewErrorResponse(err)))
+}
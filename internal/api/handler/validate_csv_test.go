@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+type csvFormField struct{ name, value string }
+
+// newCSVUpload builds a multipart request with the fields in order, then the file.
+func newCSVUpload(t *testing.T, target string, fields []csvFormField, file string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for _, field := range fields {
+		require.NoError(t, form.WriteField(field.name, field.value))
+	}
+	if file != "" {
+		part, err := form.CreateFormFile("file", "customers.csv")
+		require.NoError(t, err)
+		_, err = io.WriteString(part, file)
+		require.NoError(t, err)
+	}
+	require.NoError(t, form.Close())
+
+	req := httptest.NewRequest(http.MethodPost, target, &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	return req
+}
+
+func TestValidateCSVHandler_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    func(t *testing.T) *http.Request
+		setupMocks func(*usecase.MockValidateCSVUsecaseInterface)
+		check      func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "streams the annotated CSV",
+			request: func(t *testing.T) *http.Request {
+				return newCSVUpload(t, "/api/v1/validate-csv?mode=postal", []csvFormField{
+					{"address_column", "Address"},
+					{"min_confidence", "0.8"},
+				}, "Address\n83702\n")
+			},
+			setupMocks: func(m *usecase.MockValidateCSVUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any(), gomock.Any(), &dto.ValidateCSVRequest{
+						AddressColumn: "Address",
+						MinConfidence: 0.8,
+						Mode:          "postal",
+					}, i18n.English).
+					DoAndReturn(func(_ context.Context, in io.Reader, out io.Writer, _ *dto.ValidateCSVRequest, _ i18n.Language) (int, error) {
+						upload, err := io.ReadAll(in)
+						require.NoError(t, err)
+						_, err = out.Write(bytes.ToUpper(upload))
+						return 1, err
+					})
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename=customers-validated.csv`, rec.Header().Get("Content-Disposition"))
+				assert.Equal(t, "ADDRESS\n83702\n", rec.Body.String())
+				assert.True(t, rec.Flushed)
+			},
+		},
+		{
+			name: "mapping errors are returned as JSON",
+			request: func(t *testing.T) *http.Request {
+				return newCSVUpload(t, "/api/v1/validate-csv", []csvFormField{{"city_column", "town"}}, "city\n")
+			},
+			setupMocks: func(m *usecase.MockValidateCSVUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(0, &domainerrors.ValidationError{Field: "city_column", Reason: "column not found in the CSV header"})
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "city_column", decodeJSONError(t, rec).Errors[0].Field)
+			},
+		},
+		{
+			name: "missing file",
+			request: func(t *testing.T) *http.Request {
+				return newCSVUpload(t, "/api/v1/validate-csv", []csvFormField{{"address_column", "address"}}, "")
+			},
+			setupMocks: func(*usecase.MockValidateCSVUsecaseInterface) {},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "file field is required", decodeJSONError(t, rec).Errors[0].Reason)
+			},
+		},
+		{
+			name: "errors are in the request's language",
+			request: func(t *testing.T) *http.Request {
+				req := newCSVUpload(t, "/api/v1/validate-csv", []csvFormField{{"address_column", "address"}}, "")
+				return req.WithContext(middleware.ContextWithAcceptLanguage(req.Context(), "es"))
+			},
+			setupMocks: func(*usecase.MockValidateCSVUsecaseInterface) {},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "Falta el campo file o está vacío", decodeJSONError(t, rec).Errors[0].Reason)
+			},
+		},
+		{
+			name: "not multipart",
+			request: func(*testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/api/v1/validate-csv", strings.NewReader("address\n"))
+			},
+			setupMocks: func(*usecase.MockValidateCSVUsecaseInterface) {},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "file", decodeJSONError(t, rec).Errors[0].Field)
+			},
+		},
+		{
+			name: "non-numeric min_confidence",
+			request: func(t *testing.T) *http.Request {
+				return newCSVUpload(t, "/api/v1/validate-csv", []csvFormField{{"min_confidence", "high"}}, "address\n")
+			},
+			setupMocks: func(*usecase.MockValidateCSVUsecaseInterface) {},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "min_confidence", decodeJSONError(t, rec).Errors[0].Field)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockValidateCSVUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			rec := httptest.NewRecorder()
+			NewValidateCSVHandler(mockUsecase).ServeHTTP(rec, tt.request(t))
+
+			tt.check(t, rec)
+		})
+	}
+}
+
+func decodeJSONError(t *testing.T, rec *httptest.ResponseRecorder) *dto.ValidateResponse {
+	t.Helper()
+
+	var body struct {
+		Data *dto.ValidateResponse `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.NotNil(t, body.Data)
+	assert.False(t, body.Data.Success)
+	require.NotEmpty(t, body.Data.Errors)
+	return body.Data
+}
+
+func TestValidateCSVHandler_StreamsLargeUploads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	validator := usecase.NewMockValidateAddressUsecaseInterface(ctrl)
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Return(&dto.ValidateResponse{Success: true, Status: dto.StatusValid}, nil).AnyTimes()
+
+	server := httptest.NewServer(NewValidateCSVHandler(usecase.NewValidateCSVUsecase(validator, "")))
+	defer server.Close()
+
+	const rows = 20000
+	var file strings.Builder
+	file.WriteString("address\n")
+	for range rows {
+		file.WriteString("\"123 Main St, Springfield, IL 62701\"\n")
+	}
+
+	req := newCSVUpload(t, server.URL+"/api/v1/validate-csv?address_column=address", nil, file.String())
+	req.RequestURI = ""
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, rows+1, bytes.Count(body, []byte("\n")), "the upload is read to the end after the response starts")
+}
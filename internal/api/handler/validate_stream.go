@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// CorrelationIDHeader carries a client-chosen id echoed on every streamed result.
+const CorrelationIDHeader = "X-Correlation-ID"
+
+// ValidateStreamHandler handles the POST /api/v1/validate-stream endpoint. It is a plain
+// http.Handler so results are written while the request body is still being read.
+type ValidateStreamHandler struct {
+	validateStreamUsecase usecase.ValidateStreamUsecaseInterface
+	logger                errorLogger
+}
+
+// NewValidateStreamHandler creates a new ValidateStreamHandler.
+func NewValidateStreamHandler(validateStreamUsecase usecase.ValidateStreamUsecaseInterface) *ValidateStreamHandler {
+	return &ValidateStreamHandler{
+		validateStreamUsecase: validateStreamUsecase,
+	}
+}
+
+// Register mounts the handler on the GoFr app.
+func (h *ValidateStreamHandler) Register(app *gofr.App) {
+	h.logger = app.Logger()
+	app.UseMiddleware(middleware.Route(http.MethodPost, "/api/v1/validate-stream", h))
+}
+
+// ServeHTTP streams NDJSON results for an NDJSON request body. Errors found before the
+// first result is written are returned as JSON.
+func (h *ValidateStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	correlationID := r.Header.Get(CorrelationIDHeader)
+
+	header := map[string]string{"Content-Type": "application/x-ndjson"}
+	if correlationID != "" {
+		header[CorrelationIDHeader] = correlationID
+	}
+	out := newStreamResponseWriter(w, header)
+
+	written, err := h.validateStreamUsecase.Execute(r.Context(), r.Body, out, correlationID, responseLanguage(r.Context()))
+	switch {
+	case err == nil:
+		// An empty stream still gets its status line and headers.
+		out.start()
+	case !out.started:
+		writeJSONError(w, r, err)
+	case h.logger != nil:
+		// The status line is already sent; the truncated stream is all the client gets.
+		h.logger.Errorf("validation stream stopped after %d results: %v", written, err)
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	"github.com/williandandrade/address-validation-service/internal/api/middleware"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestValidateStreamHandler_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name           string
+		correlationID  string
+		acceptLanguage string
+		setupMocks     func(*usecase.MockValidateStreamUsecaseInterface)
+		check          func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:          "streams results with the correlation id",
+			correlationID: "batch-7",
+			setupMocks: func(m *usecase.MockValidateStreamUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any(), gomock.Any(), "batch-7", i18n.English).
+					DoAndReturn(func(_ context.Context, in io.Reader, out io.Writer, correlationID string, _ i18n.Language) (int, error) {
+						_, err := io.Copy(out, in)
+						return 1, err
+					})
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+				assert.Equal(t, "batch-7", rec.Header().Get(CorrelationIDHeader))
+				assert.Equal(t, `{"address":"83702"}`+"\n", rec.Body.String())
+				assert.True(t, rec.Flushed)
+			},
+		},
+		{
+			name: "empty stream still succeeds",
+			setupMocks: func(m *usecase.MockValidateStreamUsecaseInterface) {
+				m.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), "", i18n.English).Return(0, nil)
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+				assert.Empty(t, rec.Body.String())
+			},
+		},
+		{
+			name:           "results and errors use the request's language",
+			acceptLanguage: "es-MX",
+			setupMocks: func(m *usecase.MockValidateStreamUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any(), gomock.Any(), "", i18n.Spanish).
+					Return(0, &domainerrors.ValidationError{Code: domainerrors.CodeLimitExceeded, Field: "line", Reason: "line exceeds the maximum length of 65536 bytes"})
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "La solicitud supera el tamaño que acepta el servicio", decodeJSONError(t, rec).Errors[0].Reason)
+			},
+		},
+		{
+			name: "error before the first result is returned as JSON",
+			setupMocks: func(m *usecase.MockValidateStreamUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), gomock.Any(), gomock.Any(), "", gomock.Any()).
+					Return(0, &domainerrors.ValidationError{Field: "line", Reason: "line exceeds the maximum length of 65536 bytes"})
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+				assert.Equal(t, "line", decodeJSONError(t, rec).Errors[0].Field)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockValidateStreamUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/validate-stream", strings.NewReader(`{"address":"83702"}`+"\n"))
+			if tt.correlationID != "" {
+				req.Header.Set(CorrelationIDHeader, tt.correlationID)
+			}
+			if tt.acceptLanguage != "" {
+				req = req.WithContext(middleware.ContextWithAcceptLanguage(req.Context(), tt.acceptLanguage))
+			}
+
+			rec := httptest.NewRecorder()
+			NewValidateStreamHandler(mockUsecase).ServeHTTP(rec, req)
+
+			tt.check(t, rec)
+		})
+	}
+}
+
+func TestValidateStreamHandler_EndToEnd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	validator := usecase.NewMockValidateAddressUsecaseInterface(ctrl)
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Return(&dto.ValidateResponse{Success: true, Status: dto.StatusValid}, nil).AnyTimes()
+
+	server := httptest.NewServer(NewValidateStreamHandler(usecase.NewValidateStreamUsecase(validator, 4)))
+	defer server.Close()
+
+	const lines = 5000
+	body, bodyWriter := io.Pipe()
+	go func() {
+		for i := range lines {
+			_, _ = bodyWriter.Write([]byte(`{"id":` + strings.Repeat("1", 1+i%3) + `,"address":"123 Main St, Springfield, IL 62701"}` + "\n"))
+		}
+		bodyWriter.Close()
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, body)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	seen := map[int]bool{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var result dto.StreamValidateResult
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &result))
+		assert.True(t, result.Result.Success)
+		seen[result.Line] = true
+	}
+	require.NoError(t, scanner.Err())
+	assert.Len(t, seen, lines, "every line gets exactly one result")
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// ValidationJobHandler consumes validation jobs published by the API.
+type ValidationJobHandler struct {
+	topic                       string
+	processValidationJobUsecase usecase.ProcessValidationJobUsecaseInterface
+}
+
+// NewValidationJobHandler creates a new ValidationJobHandler subscribed to topic.
+func NewValidationJobHandler(topic string, processValidationJobUsecase usecase.ProcessValidationJobUsecaseInterface) *ValidationJobHandler {
+	return &ValidationJobHandler{
+		topic:                       topic,
+		processValidationJobUsecase: processValidationJobUsecase,
+	}
+}
+
+// Register subscribes the handler to its topic on the GoFr app.
+func (v *ValidationJobHandler) Register(app *gofr.App) {
+	app.Subscribe(v.topic, v.Handle)
+}
+
+// Handle processes one job message. Malformed messages are dropped, since redelivering
+// them cannot succeed; processing errors are returned so GoFr does not commit the
+// message and the broker redelivers it.
+func (v *ValidationJobHandler) Handle(ctx *gofr.Context) error {
+	job := new(dto.ValidationJob)
+	if err := ctx.Bind(job); err != nil || job.ID == "" {
+		return nil
+	}
+
+	return v.processValidationJobUsecase.Execute(ctx, job)
+}
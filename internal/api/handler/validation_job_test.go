@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestValidationJobHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name       string
+		message    string
+		setupMocks func(*usecase.MockProcessValidationJobUsecaseInterface)
+		expectErr  bool
+	}{
+		{
+			name:    "processes a job",
+			message: `{"id":"abc","request":{"address":"123 Main St, Springfield, IL 62701"}}`,
+			setupMocks: func(m *usecase.MockProcessValidationJobUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), &dto.ValidationJob{
+						ID:      "abc",
+						Request: dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701"},
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name:    "processing errors are returned for redelivery",
+			message: `{"id":"abc","request":{"address":"123 Main St"}}`,
+			setupMocks: func(m *usecase.MockProcessValidationJobUsecaseInterface) {
+				m.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("store unavailable"))
+			},
+			expectErr: true,
+		},
+		{
+			name:    "malformed message is dropped",
+			message: `not json`,
+		},
+		{
+			name:    "message without id is dropped",
+			message: `{"request":{"address":"123 Main St"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockProcessValidationJobUsecaseInterface(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockUsecase)
+			}
+
+			msg := pubsub.NewMessage(context.Background())
+			msg.Topic = "address-validation"
+			msg.Value = []byte(tt.message)
+
+			ctx := &gofr.Context{
+				Context:   context.Background(),
+				Request:   msg,
+				Container: nil,
+			}
+
+			h := NewValidationJobHandler("address-validation", mockUsecase)
+			err := h.Handle(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// WebhooksHandler handles the job webhook delivery endpoints.
+type WebhooksHandler struct {
+	webhooksUsecase usecase.WebhooksUsecaseInterface
+}
+
+// NewWebhooksHandler creates a new WebhooksHandler.
+func NewWebhooksHandler(webhooksUsecase usecase.WebhooksUsecaseInterface) *WebhooksHandler {
+	return &WebhooksHandler{
+		webhooksUsecase: webhooksUsecase,
+	}
+}
+
+// Register registers the webhook routes with the GoFr app.
+func (w *WebhooksHandler) Register(app *gofr.App) {
+	app.GET("/api/v1/jobs/{id}/webhooks", func(ctx *gofr.Context) (any, error) {
+		return w.HandleList(ctx)
+	})
+	app.POST("/api/v1/webhooks/{id}/redeliver", func(ctx *gofr.Context) (any, error) {
+		return w.HandleRedeliver(ctx)
+	})
+}
+
+// HandleList returns a job's webhook deliveries, including dead ones.
+func (w *WebhooksHandler) HandleList(ctx *gofr.Context) (any, error) {
+	resp, err := w.webhooksUsecase.List(ctx, ctx.PathParam("id"))
+	if err != nil {
+		return dto.NewWebhookErrorResponse(err), nil
+	}
+
+	return resp, nil
+}
+
+// HandleRedeliver queues a delivery to be sent again.
+func (w *WebhooksHandler) HandleRedeliver(ctx *gofr.Context) (any, error) {
+	resp, err := w.webhooksUsecase.Redeliver(ctx, ctx.PathParam("id"))
+	if err != nil {
+		return dto.NewWebhookErrorResponse(err), nil
+	}
+
+	return resp, nil
+}
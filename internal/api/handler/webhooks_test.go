@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func newWebhooksContext(method, target string) *gofr.Context {
+	req := httptest.NewRequest(method, target, nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "abc"})
+
+	return &gofr.Context{
+		Context:   req.Context(),
+		Request:   gofrHttp.NewRequest(req),
+		Container: nil,
+	}
+}
+
+func TestWebhooksHandler_HandleList(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*usecase.MockWebhooksUsecaseInterface)
+		checkResponse func(t *testing.T, resp *dto.WebhookDeliveriesResponse)
+	}{
+		{
+			name: "lists deliveries",
+			setupMocks: func(m *usecase.MockWebhooksUsecaseInterface) {
+				m.EXPECT().
+					List(gomock.Any(), "abc").
+					Return(&dto.WebhookDeliveriesResponse{
+						Success:    true,
+						Deliveries: []*dto.WebhookDeliveryDTO{{ID: "d1", JobID: "abc", Status: "dead"}},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *dto.WebhookDeliveriesResponse) {
+				assert.True(t, resp.Success)
+				require.Len(t, resp.Deliveries, 1)
+				assert.Equal(t, "dead", resp.Deliveries[0].Status)
+			},
+		},
+		{
+			name: "unknown job",
+			setupMocks: func(m *usecase.MockWebhooksUsecaseInterface) {
+				m.EXPECT().
+					List(gomock.Any(), "abc").
+					Return(nil, &domainerrors.NotFoundError{Resource: "job", ID: "abc"})
+			},
+			checkResponse: func(t *testing.T, resp *dto.WebhookDeliveriesResponse) {
+				assert.False(t, resp.Success)
+				assert.Equal(t, "Resource not found", resp.Message)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockWebhooksUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			result, err := NewWebhooksHandler(mockUsecase).HandleList(newWebhooksContext(http.MethodGet, "/api/v1/jobs/abc/webhooks"))
+
+			require.NoError(t, err)
+			resp, ok := result.(*dto.WebhookDeliveriesResponse)
+			require.True(t, ok)
+			tt.checkResponse(t, resp)
+		})
+	}
+}
+
+func TestWebhooksHandler_HandleRedeliver(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*usecase.MockWebhooksUsecaseInterface)
+		checkResponse func(t *testing.T, resp *dto.WebhookDeliveriesResponse)
+	}{
+		{
+			name: "delivery is queued again",
+			setupMocks: func(m *usecase.MockWebhooksUsecaseInterface) {
+				m.EXPECT().
+					Redeliver(gomock.Any(), "abc").
+					Return(&dto.WebhookDeliveriesResponse{
+						Success:    true,
+						Deliveries: []*dto.WebhookDeliveryDTO{{ID: "abc", Status: "pending"}},
+						Message:    "Delivery queued",
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *dto.WebhookDeliveriesResponse) {
+				assert.True(t, resp.Success)
+				assert.Equal(t, "pending", resp.Deliveries[0].Status)
+			},
+		},
+		{
+			name: "unknown delivery",
+			setupMocks: func(m *usecase.MockWebhooksUsecaseInterface) {
+				m.EXPECT().
+					Redeliver(gomock.Any(), "abc").
+					Return(nil, &domainerrors.NotFoundError{Resource: "webhook delivery", ID: "abc"})
+			},
+			checkResponse: func(t *testing.T, resp *dto.WebhookDeliveriesResponse) {
+				assert.False(t, resp.Success)
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, "id", resp.Errors[0].Field)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := usecase.NewMockWebhooksUsecaseInterface(ctrl)
+			tt.setupMocks(mockUsecase)
+
+			result, err := NewWebhooksHandler(mockUsecase).HandleRedeliver(newWebhooksContext(http.MethodPost, "/api/v1/webhooks/abc/redeliver"))
+
+			require.NoError(t, err)
+			resp, ok := result.(*dto.WebhookDeliveriesResponse)
+			require.True(t, ok)
+			tt.checkResponse(t, resp)
+		})
+	}
+}
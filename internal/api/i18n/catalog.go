@@ -0,0 +1,168 @@
+package i18n
+
+import (
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// errorText is the localized reason and suggestion for an error. {field} and {value}
+// are replaced with the error's field and value.
+type errorText struct {
+	Reason     string
+	Suggestion string
+	// Suggestions translates particular English suggestions, keyed by their text, for
+	// codes whose advice depends on more than the code and field, such as the mode.
+	// It wins over Suggestion.
+	Suggestions map[string]string
+}
+
+// catalog holds one language's text. Errors are keyed by code, or by code and field
+// ("FIELD_INVALID:mode") where a field needs more precise wording than its code.
+// Warnings are keyed by code; {field}, {original}, {corrected}, {postal_code} and
+// {state} are filled in from the response. Validation messages are keyed by their
+// message code, and the messages of other responses, which have no code, by their
+// English text.
+type catalog struct {
+	errors   map[string]errorText
+	warnings map[string]string
+	messages map[dto.MessageCode]string
+	texts    map[string]string
+}
+
+// catalogs has an entry for every supported language but English, which is the
+// language the service writes its messages in.
+var catalogs = map[Language]*catalog{
+	Spanish: spanish,
+}
+
+var spanish = &catalog{
+	errors: map[string]errorText{
+		string(domainerrors.CodeAddressEmpty): {
+			Reason:     "La dirección es obligatoria y no puede estar vacía",
+			Suggestion: "Indique una dirección válida de EE. UU.",
+		},
+		string(domainerrors.CodeAddressUnparseable): {
+			Reason:     "No se pudieron reconocer los componentes de la dirección",
+			Suggestion: "Asegúrese de que la dirección incluya calle, ciudad y estado",
+		},
+		string(domainerrors.CodeComponentsInsufficient): {
+			Reason: "A la dirección le faltan componentes que requiere el modo de validación",
+			Suggestions: map[string]string{
+				"Ensure address contains at least street address, city, and state": "Incluya al menos calle, ciudad y estado",
+				"Ensure address contains a city and a state":                       "Incluya una ciudad y un estado",
+				"Ensure address contains a 5-digit or ZIP+4 code":                  "Incluya un código postal de 5 dígitos o ZIP+4",
+			},
+		},
+		string(domainerrors.CodeStateInvalid): {
+			Reason:     "Código de estado no válido: {value}",
+			Suggestion: "Use un código de estado USPS de 2 letras, como IL o CA",
+		},
+		string(domainerrors.CodeZIPFormat): {
+			Reason:     "El código postal {value} no tiene un formato válido",
+			Suggestion: "Use un código postal de 5 dígitos o ZIP+4, por ejemplo 62701-1234",
+		},
+		string(domainerrors.CodeZIPRequired): {
+			Reason:     "La validación estricta requiere el código postal",
+			Suggestion: "Agregue el código postal o use la validación estándar",
+		},
+		string(domainerrors.CodeHouseNumberMissing): {
+			Reason:     "En la validación estricta, la calle debe empezar con el número de casa",
+			Suggestion: "Empiece la calle con el número de casa o use la validación estándar",
+		},
+		string(domainerrors.CodeFuzzyCorrection): {
+			Reason:     "La validación estricta no acepta componentes corregidos por aproximación",
+			Suggestion: "Indique el estado con su código USPS o use la validación estándar",
+		},
+		string(domainerrors.CodeRequestMalformed): {
+			Reason:     "No se pudo leer la solicitud",
+			Suggestion: "Revise el formato del cuerpo de la solicitud",
+		},
+		string(domainerrors.CodeFieldRequired): {
+			Reason:     "Falta el campo {field} o está vacío",
+			Suggestion: "Incluya el campo {field} en la solicitud",
+		},
+		string(domainerrors.CodeFieldInvalid): {
+			Reason: "El campo {field} tiene un valor no válido",
+			Suggestions: map[string]string{
+				"Use a column name from the file's first row":                                           "Use un nombre de columna de la primera fila del archivo",
+				"Map either one address column or the separate component columns":                       "Asigne una sola columna de dirección o las columnas de cada componente",
+				"Use a URL like https://example.com/hooks/jobs":                                         "Use una URL como https://example.com/hooks/jobs",
+				"Use a host name that resolves in public DNS":                                           "Use un nombre de host que se resuelva en el DNS público",
+				"Use a publicly reachable host; loopback, private and link-local addresses are refused": "Use un host accesible públicamente; se rechazan las direcciones de loopback, privadas y de enlace local",
+				"Use job.completed or item.completed":                                                   "Use job.completed o item.completed",
+				"Use a value between 0 and 1, e.g. 0.8":                                                 "Use un valor entre 0 y 1, por ejemplo 0.8",
+				"Omit page to use the default":                                                          "Omita page para usar el valor predeterminado",
+				"Omit page_size to use the default":                                                     "Omita page_size para usar el valor predeterminado",
+			},
+		},
+		string(domainerrors.CodeFieldOutOfRange): {
+			Reason:     "El campo {field} está fuera del rango permitido",
+			Suggestion: "Use un valor dentro del rango u omita el campo",
+		},
+		string(domainerrors.CodeLimitExceeded): {
+			Reason:     "La solicitud supera el tamaño que acepta el servicio",
+			Suggestion: "Divida la solicitud en partes más pequeñas",
+		},
+		string(domainerrors.CodeWebhooksDisabled): {
+			Reason:     "Los webhooks no están habilitados para este cliente",
+			Suggestion: "Pida a un operador que configure un secreto de webhook para el cliente",
+		},
+		string(domainerrors.CodeTimeout): {
+			Reason:     "La validación no terminó a tiempo",
+			Suggestion: "Reintente la solicitud; el resultado se entregará cuando termine la validación",
+		},
+		string(domainerrors.CodeNotFound): {
+			Reason: "No se encontró el recurso {value}",
+		},
+
+		string(domainerrors.CodeFieldInvalid) + ":mode": {
+			Reason:     "mode debe ser full, locality o postal",
+			Suggestion: "Omita mode para direcciones completas, o use locality o postal",
+		},
+		string(domainerrors.CodeFieldInvalid) + ":strictness": {
+			Reason:     "strictness debe ser strict, standard o lenient",
+			Suggestion: "Omita strictness para usar el valor predeterminado del servicio",
+		},
+		string(domainerrors.CodeFieldInvalid) + ":format": {
+			Reason:     "format debe ser single_line, multi_line o usps_label",
+			Suggestion: "Omita format para recibir solo formatted_address",
+		},
+		string(domainerrors.CodeFieldRequired) + ":max_line_length": {
+			Reason:     "max_line_length requiere un formato",
+			Suggestion: "Asigne a format el valor usps_label, multi_line o single_line",
+		},
+		string(domainerrors.CodeFieldOutOfRange) + ":max_line_length": {
+			Reason:     "max_line_length debe estar entre 20 y 200",
+			Suggestion: "Use el límite de su transportista, como 35 o 40",
+		},
+		string(domainerrors.CodeFieldOutOfRange) + ":min_confidence": {
+			Reason:     "min_confidence debe estar entre 0 y 1",
+			Suggestion: "Use un umbral como 0.7 u omita el campo",
+		},
+	},
+	warnings: map[string]string{
+		string(domainerrors.CodeComponentCorrected): "{field} se cambió de {original} a {corrected}",
+		string(domainerrors.CodeComponentDropped):   "Se descartó {field} {original} porque no tenía un formato válido",
+		string(domainerrors.CodeComponentInferred):  "{field} no se indicó y se completó con datos de referencia",
+		string(domainerrors.CodeAddressAmbiguous):   "La dirección tiene más de una interpretación válida; candidates enumera las demás",
+		string(domainerrors.CodeZIPStateMismatch):   "El código postal {postal_code} no pertenece al estado {state}",
+	},
+	messages: map[dto.MessageCode]string{
+		dto.MessageValidated:      "Dirección validada correctamente",
+		dto.MessageAmbiguous:      "Se encontraron varias interpretaciones válidas; se devuelve la coincidencia más poblada",
+		dto.MessageLowConfidence:  "La confianza en la dirección está por debajo del mínimo solicitado",
+		dto.MessageRequestInvalid: "La solicitud no es válida",
+		dto.MessageNotNormalized:  "No se pudo normalizar la dirección",
+		dto.MessageTimeout:        "La validación no terminó a tiempo",
+		dto.MessageNotFound:       "No se encontró el recurso",
+		dto.MessageInternal:       "Error interno del servidor",
+	},
+	texts: map[string]string{
+		"No addresses found": "No se encontraron direcciones",
+		"Found 1 address":    "Se encontró 1 dirección",
+		"Job queued":         "Trabajo en cola",
+		"Job running":        "Trabajo en curso",
+		"Job done":           "Trabajo terminado",
+		"Job failed":         "Trabajo fallido",
+	},
+}
@@ -0,0 +1,67 @@
+// Package i18n localizes the human-readable text of API responses. Catalogs are
+// keyed by error code, so a code always reads the same whatever produced it.
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Language is a supported response language, as its ISO 639-1 code.
+type Language string
+
+const (
+	// English is the language the service writes its messages in, and the fallback.
+	English Language = "en"
+	// Spanish messages come from the Spanish catalog.
+	Spanish Language = "es"
+)
+
+// Supported lists the response languages in order of preference on a tie.
+var Supported = []Language{English, Spanish}
+
+// Negotiate returns the supported language the Accept-Language header ranks
+// highest. Regional tags match their language, so es-MX selects Spanish. An empty
+// header, or one accepting no supported language, gets English.
+func Negotiate(acceptLanguage string) Language {
+	best, bestQuality := English, 0.0
+	for _, lang := range Supported {
+		if quality := languageQuality(acceptLanguage, lang); quality > bestQuality {
+			best, bestQuality = lang, quality
+		}
+	}
+	return best
+}
+
+// languageQuality returns the q value the Accept-Language header gives lang, 0 when
+// it is not accepted. An exact or regional match outranks the * wildcard.
+func languageQuality(acceptLanguage string, lang Language) float64 {
+	quality, specificity := 0.0, -1
+	for _, languageRange := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(languageRange), ";")
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+
+		var rank int
+		switch primary {
+		case string(lang):
+			rank = 1
+		case "*":
+			rank = 0
+		default:
+			continue
+		}
+		if rank <= specificity {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				q = 0
+			}
+		}
+		quality, specificity = q, rank
+	}
+	return quality
+}
@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       Language
+	}{
+		{"no header", "", English},
+		{"spanish", "es", Spanish},
+		{"regional tag", "es-MX", Spanish},
+		{"case-insensitive", "ES-us", Spanish},
+		{"quality order", "en;q=0.5, es;q=0.9", Spanish},
+		{"preferred unsupported language falls back to the next", "fr-CA, es;q=0.8", Spanish},
+		{"only unsupported languages", "fr, de;q=0.5", English},
+		{"wildcard", "*", English},
+		{"wildcard below spanish", "es;q=0.4, *;q=0.1", Spanish},
+		{"refused language", "es;q=0", English},
+		{"unparsable quality", "es;q=high", English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Negotiate(tt.acceptLanguage))
+		})
+	}
+}
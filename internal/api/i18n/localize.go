@@ -0,0 +1,213 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// Errors returns errs with their reasons and suggestions in lang. An error keeps its
+// English text when the catalog has no entry for its code, or when the entry needs a
+// value the error does not carry. A suggestion also stays in English when the entry
+// has no translation for it.
+func (lang Language) Errors(errs []dto.ErrorDTO) []dto.ErrorDTO {
+	c := catalogs[lang]
+	if c == nil || len(errs) == 0 {
+		return errs
+	}
+
+	out := make([]dto.ErrorDTO, len(errs))
+	for i, e := range errs {
+		out[i] = e
+		text, ok := c.errors[e.Code+":"+e.Field]
+		if !ok {
+			text, ok = c.errors[e.Code]
+		}
+		if !ok {
+			continue
+		}
+
+		value := ""
+		if e.Value != nil {
+			value = fmt.Sprint(e.Value)
+		}
+		if value == "" && strings.Contains(text.Reason, "{value}") {
+			continue
+		}
+
+		r := strings.NewReplacer("{field}", e.Field, "{value}", value)
+		out[i].Reason = r.Replace(text.Reason)
+		if suggestion, ok := text.Suggestions[e.Suggestion]; ok {
+			out[i].Suggestion = r.Replace(suggestion)
+		} else if e.Suggestion != "" && text.Suggestion != "" {
+			out[i].Suggestion = r.Replace(text.Suggestion)
+		}
+	}
+	return out
+}
+
+// Message returns message in lang, or unchanged when the catalog has no translation.
+// A message with a code, such as the error message other responses copy from a
+// ValidateResponse, is translated by its code.
+func (lang Language) Message(message string) string {
+	if code, ok := dto.MessageCodeOf(message); ok {
+		return lang.codedMessage(code, message)
+	}
+	if c := catalogs[lang]; c != nil {
+		if translated, ok := c.texts[message]; ok {
+			return translated
+		}
+	}
+	return message
+}
+
+// codedMessage returns the message for code in lang, or message when the catalog has
+// no translation for it.
+func (lang Language) codedMessage(code dto.MessageCode, message string) string {
+	if c := catalogs[lang]; c != nil {
+		if translated, ok := c.messages[code]; ok {
+			return translated
+		}
+	}
+	return message
+}
+
+// ValidateResponse returns a copy of resp with its message, errors and warnings in
+// lang. resp itself is left as is, since it may be shared through a response cache.
+func (lang Language) ValidateResponse(resp *dto.ValidateResponse) *dto.ValidateResponse {
+	if resp == nil || catalogs[lang] == nil {
+		return resp
+	}
+
+	localized := *resp
+	if resp.MessageCode != "" {
+		localized.Message = lang.codedMessage(resp.MessageCode, resp.Message)
+	} else {
+		// Results stored before message codes existed carry only the text.
+		localized.Message = lang.Message(resp.Message)
+	}
+	localized.Errors = lang.Errors(resp.Errors)
+	localized.Warnings = lang.warnings(resp)
+	return &localized
+}
+
+// ExtractResponse returns a copy of resp with its message, its errors and the result of
+// every address found in lang.
+func (lang Language) ExtractResponse(resp *dto.ExtractResponse) *dto.ExtractResponse {
+	if resp == nil || catalogs[lang] == nil {
+		return resp
+	}
+
+	localized := *resp
+	localized.Message = lang.Message(resp.Message)
+	localized.Errors = lang.Errors(resp.Errors)
+	localized.Addresses = make([]*dto.ExtractedAddressDTO, len(resp.Addresses))
+	for i, address := range resp.Addresses {
+		found := *address
+		found.Result = lang.ValidateResponse(address.Result)
+		localized.Addresses[i] = &found
+	}
+	return &localized
+}
+
+// JobResponse returns a copy of resp with its message, its errors and the result of
+// every address in lang.
+func (lang Language) JobResponse(resp *dto.JobResponse) *dto.JobResponse {
+	if resp == nil || catalogs[lang] == nil {
+		return resp
+	}
+
+	localized := *resp
+	localized.Message = lang.Message(resp.Message)
+	localized.Errors = lang.Errors(resp.Errors)
+	if resp.Results != nil {
+		localized.Results = make([]*dto.JobResultDTO, len(resp.Results))
+		for i, result := range resp.Results {
+			item := *result
+			item.Result = lang.ValidateResponse(result.Result)
+			localized.Results[i] = &item
+		}
+	}
+	return &localized
+}
+
+// warnings returns resp's warnings with their messages in lang. The values a message
+// names come from the rest of resp: the correction behind a corrected or dropped
+// component, and the address for a ZIP code in another state. A warning keeps its
+// English message when the catalog has no entry for its code or resp lacks a value.
+func (lang Language) warnings(resp *dto.ValidateResponse) []dto.WarningDTO {
+	c := catalogs[lang]
+	if len(resp.Warnings) == 0 {
+		return resp.Warnings
+	}
+
+	out := make([]dto.WarningDTO, len(resp.Warnings))
+	for i, w := range resp.Warnings {
+		out[i] = w
+		text, ok := c.warnings[w.Code]
+		if !ok {
+			continue
+		}
+
+		values := map[string]string{"field": w.Field}
+		if correction := warningCorrection(resp, w); correction != nil {
+			values["original"], values["corrected"] = correction.Original, correction.Corrected
+		}
+		if resp.Address != nil {
+			values["postal_code"], values["state"] = resp.Address.PostalCode, resp.Address.State
+		}
+		if message, ok := fill(text, values); ok {
+			out[i].Message = message
+		}
+	}
+	return out
+}
+
+// warningCorrection returns the correction a COMPONENT_CORRECTED or COMPONENT_DROPPED
+// warning reports, or nil for other warnings.
+func warningCorrection(resp *dto.ValidateResponse, w dto.WarningDTO) *dto.CorrectionDTO {
+	var dropped bool
+	switch w.Code {
+	case string(domainerrors.CodeComponentCorrected):
+	case string(domainerrors.CodeComponentDropped):
+		dropped = true
+	default:
+		return nil
+	}
+
+	for _, c := range resp.Corrections {
+		if c.Component == w.Field && (c.Type == string(entity.CorrectionDropped)) == dropped {
+			return c
+		}
+	}
+	return nil
+}
+
+// fill replaces each {name} in text with values[name]. It reports false when text names
+// a value that is missing or empty.
+func fill(text string, values map[string]string) (string, bool) {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(text, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start:], '}')
+		if end < 0 {
+			break
+		}
+
+		value := values[text[start+1:start+end]]
+		if value == "" {
+			return "", false
+		}
+		b.WriteString(text[:start])
+		b.WriteString(value)
+		text = text[start+end+1:]
+	}
+	b.WriteString(text)
+	return b.String(), true
+}
@@ -0,0 +1,228 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+func TestLanguage_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     Language
+		err      dto.ErrorDTO
+		expected dto.ErrorDTO
+	}{
+		{
+			name:     "english keeps the service text",
+			lang:     English,
+			err:      dto.ErrorDTO{Code: "STATE_INVALID", Field: "state", Reason: "invalid state code: XX", Value: "XX", Suggestion: "Use a 2-letter USPS state code such as IL or CA"},
+			expected: dto.ErrorDTO{Code: "STATE_INVALID", Field: "state", Reason: "invalid state code: XX", Value: "XX", Suggestion: "Use a 2-letter USPS state code such as IL or CA"},
+		},
+		{
+			name:     "code entry with the value filled in",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "STATE_INVALID", Field: "state", Reason: "invalid state code: XX", Value: "XX", Suggestion: "Use a 2-letter USPS state code such as IL or CA"},
+			expected: dto.ErrorDTO{Code: "STATE_INVALID", Field: "state", Reason: "Código de estado no válido: XX", Value: "XX", Suggestion: "Use un código de estado USPS de 2 letras, como IL o CA"},
+		},
+		{
+			name:     "field entry wins over the code entry",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "FIELD_INVALID", Field: "mode", Reason: "mode must be one of full, locality, postal", Suggestion: "Omit mode for full addresses, or use locality or postal"},
+			expected: dto.ErrorDTO{Code: "FIELD_INVALID", Field: "mode", Reason: "mode debe ser full, locality o postal", Suggestion: "Omita mode para direcciones completas, o use locality o postal"},
+		},
+		{
+			name:     "code entry with the field filled in",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "FIELD_REQUIRED", Field: "q", Reason: "q is required and cannot be empty", Suggestion: "Provide the partial address typed so far"},
+			expected: dto.ErrorDTO{Code: "FIELD_REQUIRED", Field: "q", Reason: "Falta el campo q o está vacío", Suggestion: "Incluya el campo q en la solicitud"},
+		},
+		{
+			name:     "no suggestion is added",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "ADDRESS_UNPARSEABLE", Field: "address_b", Reason: "address could not be normalized"},
+			expected: dto.ErrorDTO{Code: "ADDRESS_UNPARSEABLE", Field: "address_b", Reason: "No se pudieron reconocer los componentes de la dirección"},
+		},
+		{
+			name:     "missing value keeps the service text",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "ZIP_FORMAT", Field: "zip", Reason: "zip must be a 5-digit ZIP code"},
+			expected: dto.ErrorDTO{Code: "ZIP_FORMAT", Field: "zip", Reason: "zip must be a 5-digit ZIP code"},
+		},
+		{
+			name:     "suggestion translated by its english text",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "COMPONENTS_INSUFFICIENT", Field: "address", Reason: "city and state must be present", Suggestion: "Ensure address contains a city and a state"},
+			expected: dto.ErrorDTO{Code: "COMPONENTS_INSUFFICIENT", Field: "address", Reason: "A la dirección le faltan componentes que requiere el modo de validación", Suggestion: "Incluya una ciudad y un estado"},
+		},
+		{
+			name:     "untranslated suggestion keeps the service text",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "FIELD_INVALID", Field: "callback_events", Reason: "unknown callback event", Value: "job.started", Suggestion: "Use job.completed, item.completed or job.started"},
+			expected: dto.ErrorDTO{Code: "FIELD_INVALID", Field: "callback_events", Reason: "El campo callback_events tiene un valor no válido", Value: "job.started", Suggestion: "Use job.completed, item.completed or job.started"},
+		},
+		{
+			name:     "unknown code keeps the service text",
+			lang:     Spanish,
+			err:      dto.ErrorDTO{Code: "SOMETHING_NEW", Field: "address", Reason: "something new"},
+			expected: dto.ErrorDTO{Code: "SOMETHING_NEW", Field: "address", Reason: "something new"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, []dto.ErrorDTO{tt.expected}, tt.lang.Errors([]dto.ErrorDTO{tt.err}))
+		})
+	}
+}
+
+func TestLanguage_Message(t *testing.T) {
+	assert.Equal(t, "Dirección validada correctamente", Spanish.Message("Address validated successfully"))
+	assert.Equal(t, "Address validated successfully", English.Message("Address validated successfully"))
+	assert.Equal(t, "Found 2 addresses", Spanish.Message("Found 2 addresses"))
+	assert.Equal(t, "Se encontró 1 dirección", Spanish.Message("Found 1 address"))
+}
+
+func TestLanguage_ValidateResponse(t *testing.T) {
+	resp := &dto.ValidateResponse{
+		Errors: []dto.ErrorDTO{{Code: string(domainerrors.CodeZIPRequired), Field: "postal_code", Reason: "postal_code is required by strict validation"}},
+	}
+	resp.SetMessage(dto.MessageNotNormalized)
+
+	localized := Spanish.ValidateResponse(resp)
+
+	assert.Equal(t, "No se pudo normalizar la dirección", localized.Message)
+	require.Len(t, localized.Errors, 1)
+	assert.Equal(t, "La validación estricta requiere el código postal", localized.Errors[0].Reason)
+	// The original may be cached, so it is left in English.
+	assert.Equal(t, "Address could not be normalized", resp.Message)
+	assert.Equal(t, "postal_code is required by strict validation", resp.Errors[0].Reason)
+	assert.Same(t, resp, English.ValidateResponse(resp))
+}
+
+func TestLanguage_ValidateResponse_MessageCode(t *testing.T) {
+	t.Run("translated by code, whatever the English text", func(t *testing.T) {
+		resp := &dto.ValidateResponse{MessageCode: dto.MessageValidated, Message: "The address was validated"}
+
+		assert.Equal(t, "Dirección validada correctamente", Spanish.ValidateResponse(resp).Message)
+	})
+
+	t.Run("a stored result without a code is translated by its text", func(t *testing.T) {
+		resp := &dto.ValidateResponse{Message: dto.MessageLowConfidence.Text()}
+
+		assert.Equal(t, "La confianza en la dirección está por debajo del mínimo solicitado", Spanish.ValidateResponse(resp).Message)
+	})
+}
+
+func TestLanguage_ValidateResponse_Warnings(t *testing.T) {
+	resp := &dto.ValidateResponse{
+		Address: &dto.AddressDTO{City: "Springfield", State: "NY", PostalCode: "62701"},
+		Corrections: []*dto.CorrectionDTO{
+			{Component: "city", Original: "springfield", Corrected: "Springfield", Type: "capitalization"},
+			{Component: "state", Original: "XX", Type: "dropped"},
+		},
+		Warnings: []dto.WarningDTO{
+			{Code: "COMPONENT_CORRECTED", Field: "city", Message: "city was changed from springfield to Springfield"},
+			{Code: "COMPONENT_DROPPED", Field: "state", Message: "state XX was dropped: unrecognized state code"},
+			{Code: "COMPONENT_INFERRED", Field: "state", Message: "state was not given and was filled in from reference data"},
+			{Code: "ZIP_STATE_MISMATCH", Field: "postal_code", Message: "postal_code 62701 is not assigned to state NY"},
+			{Code: "COMPONENT_CORRECTED", Field: "street_address", Message: "street_address was changed from a to b"},
+		},
+	}
+
+	localized := Spanish.ValidateResponse(resp)
+
+	require.Len(t, localized.Warnings, 5)
+	assert.Equal(t, "city se cambió de springfield a Springfield", localized.Warnings[0].Message)
+	assert.Equal(t, "Se descartó state XX porque no tenía un formato válido", localized.Warnings[1].Message)
+	assert.Equal(t, "state no se indicó y se completó con datos de referencia", localized.Warnings[2].Message)
+	assert.Equal(t, "El código postal 62701 no pertenece al estado NY", localized.Warnings[3].Message)
+	assert.Equal(t, "street_address was changed from a to b", localized.Warnings[4].Message, "without its correction the warning stays in English")
+	assert.Equal(t, "city was changed from springfield to Springfield", resp.Warnings[0].Message)
+}
+
+func TestLanguage_NestedResponses(t *testing.T) {
+	result := &dto.ValidateResponse{
+		Errors:  []dto.ErrorDTO{{Code: string(domainerrors.CodeZIPRequired), Field: "postal_code", Reason: "postal_code is required by strict validation"}},
+		Message: "Address could not be normalized",
+	}
+
+	extract := Spanish.ExtractResponse(&dto.ExtractResponse{Addresses: []*dto.ExtractedAddressDTO{{Text: "a", Result: result}}, Message: "Found 1 address"})
+	assert.Equal(t, "Se encontró 1 dirección", extract.Message)
+	assert.Equal(t, "No se pudo normalizar la dirección", extract.Addresses[0].Result.Message)
+
+	job := Spanish.JobResponse(&dto.JobResponse{Results: []*dto.JobResultDTO{{Index: 0, Result: result}, {Index: 1}}, Message: "Job done"})
+	assert.Equal(t, "Trabajo terminado", job.Message)
+	assert.Equal(t, "La validación estricta requiere el código postal", job.Results[0].Result.Errors[0].Reason)
+	assert.Nil(t, job.Results[1].Result)
+
+	assert.Equal(t, "Address could not be normalized", result.Message, "the originals are left in English")
+}
+
+// Every validation message must be translated, so a new message cannot ship in English only.
+func TestCatalogs_TranslateEveryMessage(t *testing.T) {
+	known := make(map[dto.MessageCode]bool)
+	for _, code := range dto.MessageCodes() {
+		known[code] = true
+		assert.NotEmpty(t, code.Text(), "message %s has no English text", code)
+	}
+
+	for lang, c := range catalogs {
+		for code := range known {
+			assert.NotEmpty(t, c.messages[code], "%s catalog has no translation for message %s", lang, code)
+		}
+		for code := range c.messages {
+			assert.True(t, known[code], "%s catalog has a translation for unknown message %s", lang, code)
+		}
+		for text := range c.texts {
+			_, coded := dto.MessageCodeOf(text)
+			assert.False(t, coded, "%s catalog translates %q by its text; key it by its code", lang, text)
+		}
+	}
+}
+
+// Every catalog code must exist, so a renamed code cannot leave a dead entry.
+func TestCatalogs_KnownCodes(t *testing.T) {
+	known := make(map[string]bool)
+	for _, code := range []domainerrors.Code{
+		domainerrors.CodeAddressEmpty, domainerrors.CodeAddressUnparseable, domainerrors.CodeComponentsInsufficient,
+		domainerrors.CodeStateInvalid, domainerrors.CodeZIPFormat, domainerrors.CodeZIPRequired,
+		domainerrors.CodeHouseNumberMissing, domainerrors.CodeFuzzyCorrection,
+		domainerrors.CodeRequestMalformed, domainerrors.CodeFieldRequired, domainerrors.CodeFieldInvalid,
+		domainerrors.CodeFieldOutOfRange, domainerrors.CodeLimitExceeded, domainerrors.CodeWebhooksDisabled,
+		domainerrors.CodeTimeout, domainerrors.CodeNotFound,
+	} {
+		known[string(code)] = true
+	}
+
+	warningCodes := map[string]bool{}
+	for _, code := range []domainerrors.Code{
+		domainerrors.CodeComponentInferred, domainerrors.CodeComponentCorrected, domainerrors.CodeComponentDropped,
+		domainerrors.CodeAddressAmbiguous, domainerrors.CodeZIPStateMismatch,
+	} {
+		warningCodes[string(code)] = true
+	}
+
+	for lang, c := range catalogs {
+		for code := range warningCodes {
+			_, ok := c.warnings[code]
+			assert.True(t, ok, "%s catalog has no warning entry for %s", lang, code)
+		}
+		for code := range c.warnings {
+			assert.True(t, warningCodes[code], "%s catalog has a warning entry for unknown code %s", lang, code)
+		}
+		for key := range c.errors {
+			code, _, _ := strings.Cut(key, ":")
+			assert.True(t, known[code], "%s catalog has an entry for unknown code %s", lang, code)
+		}
+		for code := range known {
+			_, ok := c.errors[code]
+			assert.True(t, ok, "%s catalog has no entry for %s", lang, code)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+type acceptKey struct{}
+
+// Accept stores the request's Accept header in its context. GoFr handlers do not
+// see request headers, and the ones that negotiate their response format need it.
+func Accept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if accept := r.Header.Get("Accept"); accept != "" {
+			r = r.WithContext(ContextWithAccept(r.Context(), accept))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ContextWithAccept returns a context carrying a request's Accept header.
+func ContextWithAccept(ctx context.Context, accept string) context.Context {
+	return context.WithValue(ctx, acceptKey{}, accept)
+}
+
+// AcceptFromContext returns the Accept header carried by ctx, or "" when there is none.
+func AcceptFromContext(ctx context.Context) string {
+	accept, _ := ctx.Value(acceptKey{}).(string)
+	return accept
+}
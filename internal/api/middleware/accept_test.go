@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccept(t *testing.T) {
+	var got string
+	handler := Accept(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = AcceptFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Accept", "application/xml")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "application/xml", got)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Empty(t, got)
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		target        string
+		authorization string
+		wantStatus    int
+		wantServed    bool
+	}{
+		{name: "valid token is served", token: "s3cret", target: "/admin", authorization: "Bearer s3cret", wantStatus: http.StatusOK, wantServed: true},
+		{name: "scheme is case-insensitive", token: "s3cret", target: "/admin", authorization: "bearer s3cret", wantStatus: http.StatusOK, wantServed: true},
+		{name: "missing token is rejected", token: "s3cret", target: "/admin", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token is rejected", token: "s3cret", target: "/admin", authorization: "Bearer guess", wantStatus: http.StatusUnauthorized},
+		{name: "other scheme is rejected", token: "s3cret", target: "/admin", authorization: "Basic s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "empty configured token rejects everyone", target: "/admin", authorization: "Bearer ", wantStatus: http.StatusUnauthorized},
+		{name: "other paths pass through", token: "s3cret", target: "/public", wantStatus: http.StatusOK, wantServed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var served bool
+			next := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { served = true })
+
+			req := httptest.NewRequest(http.MethodPost, tt.target, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			AdminToken(tt.token, "/admin")(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantServed, served)
+			if !tt.wantServed {
+				assert.Equal(t, `Bearer realm="admin"`, rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
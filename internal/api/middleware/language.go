@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+type acceptLanguageKey struct{}
+
+// AcceptLanguage stores the request's Accept-Language header in its context, so
+// handlers can localize the messages in their responses.
+func AcceptLanguage(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if acceptLanguage := r.Header.Get("Accept-Language"); acceptLanguage != "" {
+			r = r.WithContext(ContextWithAcceptLanguage(r.Context(), acceptLanguage))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ContextWithAcceptLanguage returns a context carrying a request's Accept-Language header.
+func ContextWithAcceptLanguage(ctx context.Context, acceptLanguage string) context.Context {
+	return context.WithValue(ctx, acceptLanguageKey{}, acceptLanguage)
+}
+
+// AcceptLanguageFromContext returns the Accept-Language header carried by ctx, or "" when there is none.
+func AcceptLanguageFromContext(ctx context.Context) string {
+	acceptLanguage, _ := ctx.Value(acceptLanguageKey{}).(string)
+	return acceptLanguage
+}
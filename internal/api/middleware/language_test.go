@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptLanguage(t *testing.T) {
+	var got string
+	handler := AcceptLanguage(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = AcceptLanguageFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Accept-Language", "es-MX,es;q=0.9")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "es-MX,es;q=0.9", got)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Empty(t, got)
+}
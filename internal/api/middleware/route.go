@@ -0,0 +1,19 @@
+package middleware
+
+import "net/http"
+
+// Route serves requests for method and path with handler instead of passing them on.
+// GoFr handlers return one value that is written when they finish, so endpoints that
+// stream their response or choose their own status are mounted this way. GoFr's own middleware, such as logging
+// and metrics, still runs first.
+func Route(method, path string, handler http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == method && r.URL.Path == path {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
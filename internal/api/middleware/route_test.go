@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		want   string
+	}{
+		{name: "matching route is served directly", method: http.MethodPost, target: "/stream?x=1", want: "route"},
+		{name: "other method passes through", method: http.MethodGet, target: "/stream", want: "next"},
+		{name: "other path passes through", method: http.MethodPost, target: "/stream/1", want: "next"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			route := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { got = "route" })
+			next := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { got = "next" })
+
+			Route(http.MethodPost, "/stream", route)(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.target, nil))
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Package middleware holds HTTP middleware shared by every route.
+package middleware
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+// TenantHeader names the tenant a request acts for. Only a trusted proxy, such as the
+// gateway in front of the service, may set it; see Tenant.
+const TenantHeader = "X-Tenant-ID"
+
+// Tenant returns middleware that stores the request's tenant in its context. The tenant
+// is read from TenantHeader on requests whose peer address is in trusted. Anyone else's
+// header is removed, so a client cannot pick its tenant by sending one. Requests without
+// a trusted header belong to the default tenant.
+func Tenant(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !fromTrustedProxy(r, trusted) {
+				r.Header.Del(TenantHeader)
+			} else if tenant := strings.TrimSpace(r.Header.Get(TenantHeader)); tenant != "" {
+				r = r.WithContext(entity.ContextWithTenant(r.Context(), tenant))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// fromTrustedProxy reports whether r arrived directly from an address in trusted.
+func fromTrustedProxy(r *http.Request, trusted []netip.Prefix) bool {
+	peer, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+
+	addr := peer.Addr().Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
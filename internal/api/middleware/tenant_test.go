@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func TestTenant(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     string
+		want       string
+		wantHeader string
+	}{
+		{name: "trusted proxy names the tenant", remoteAddr: "10.1.2.3:5000", header: "acme", want: "acme", wantHeader: "acme"},
+		{name: "surrounding space is ignored", remoteAddr: "10.1.2.3:5000", header: " acme ", want: "acme", wantHeader: " acme "},
+		{name: "missing header is the default tenant", remoteAddr: "10.1.2.3:5000", want: entity.DefaultTenant},
+		{name: "client header is dropped", remoteAddr: "203.0.113.7:5000", header: "acme", want: entity.DefaultTenant},
+		{name: "ipv4-mapped proxy address is trusted", remoteAddr: "[::ffff:10.1.2.3]:5000", header: "acme", want: "acme", wantHeader: "acme"},
+		{name: "unparseable peer is not trusted", remoteAddr: "pipe", header: "acme", want: entity.DefaultTenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got, gotHeader string
+			handler := Tenant(trusted)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = entity.TenantFromContext(r.Context())
+				gotHeader = r.Header.Get(TenantHeader)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.header != "" {
+				req.Header.Set(TenantHeader, tt.header)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantHeader, gotHeader)
+		})
+	}
+}
+
+func TestTenant_NoTrustedProxies(t *testing.T) {
+	var got string
+	handler := Tenant(nil)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = entity.TenantFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(TenantHeader, "acme")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, entity.DefaultTenant, got)
+}
@@ -0,0 +1,1618 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: addressvalidation/v1/address_validation.proto
+
+package addressvalidationv1
+
+import (
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidateRequest mirrors the JSON body of POST /api/v1/validate-address.
+type ValidateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// include_components adds per-component offsets into address to the response.
+	IncludeComponents bool `protobuf:"varint,2,opt,name=include_components,json=includeComponents,proto3" json:"include_components,omitempty"`
+	// min_confidence marks results whose overall score falls below it as unverifiable (0 disables).
+	MinConfidence float64 `protobuf:"fixed64,3,opt,name=min_confidence,json=minConfidence,proto3" json:"min_confidence,omitempty"`
+	// mode is "full" (default), "locality" or "postal".
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// strictness is "strict", "standard" or "lenient"; empty uses the service default.
+	Strictness string `protobuf:"bytes,5,opt,name=strictness,proto3" json:"strictness,omitempty"`
+	// format adds the address rendered as "single_line", "multi_line" or "usps_label".
+	Format string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	// max_line_length caps each formatted line, abbreviating to fit (0 disables; requires format).
+	MaxLineLength int32 `protobuf:"varint,7,opt,name=max_line_length,json=maxLineLength,proto3" json:"max_line_length,omitempty"`
+	// explain adds the ordered trace of decisions behind the result to the response.
+	Explain       bool `protobuf:"varint,8,opt,name=explain,proto3" json:"explain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidateRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetIncludeComponents() bool {
+	if x != nil {
+		return x.IncludeComponents
+	}
+	return false
+}
+
+func (x *ValidateRequest) GetMinConfidence() float64 {
+	if x != nil {
+		return x.MinConfidence
+	}
+	return 0
+}
+
+func (x *ValidateRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetStrictness() string {
+	if x != nil {
+		return x.Strictness
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetMaxLineLength() int32 {
+	if x != nil {
+		return x.MaxLineLength
+	}
+	return 0
+}
+
+func (x *ValidateRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+// ValidateResponse is a successfully validated address.
+type ValidateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is "valid", "corrected" or "unverifiable".
+	Status             string      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Strictness         string      `protobuf:"bytes,2,opt,name=strictness,proto3" json:"strictness,omitempty"`
+	Address            *Address    `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Candidates         []*Address  `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Confidence         *Confidence `protobuf:"bytes,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	CorrectionsApplied []string    `protobuf:"bytes,6,rep,name=corrections_applied,json=correctionsApplied,proto3" json:"corrections_applied,omitempty"`
+	// components is keyed by component name: street_address, city, state or postal_code.
+	Components map[string]*Component `protobuf:"bytes,7,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// cache is "hit" or "miss" when a result cache is configured.
+	Cache   string `protobuf:"bytes,8,opt,name=cache,proto3" json:"cache,omitempty"`
+	Message string `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	// trace lists the decisions behind the result, in order, when the request set explain.
+	Trace []*Decision `protobuf:"bytes,10,rep,name=trace,proto3" json:"trace,omitempty"`
+	// corrections lists what normalization changed; corrections_applied renders it as plain text.
+	Corrections []*Correction `protobuf:"bytes,11,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	// warnings lists non-fatal issues, such as inferred or corrected components.
+	Warnings      []*Warning `protobuf:"bytes,12,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetStrictness() string {
+	if x != nil {
+		return x.Strictness
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetCandidates() []*Address {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetConfidence() *Confidence {
+	if x != nil {
+		return x.Confidence
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetCorrectionsApplied() []string {
+	if x != nil {
+		return x.CorrectionsApplied
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetComponents() map[string]*Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetCache() string {
+	if x != nil {
+		return x.Cache
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetTrace() []*Decision {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetCorrections() []*Correction {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// Address is a normalized address.
+type Address struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StreetAddress    string                 `protobuf:"bytes,1,opt,name=street_address,json=streetAddress,proto3" json:"street_address,omitempty"`
+	City             string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	State            string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode       string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	AddressType      string                 `protobuf:"bytes,5,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	FormattedAddress string                 `protobuf:"bytes,6,opt,name=formatted_address,json=formattedAddress,proto3" json:"formatted_address,omitempty"`
+	// canonical_key identifies the place for dedupe and joins; canonical_hash is its digest.
+	CanonicalKey  string `protobuf:"bytes,7,opt,name=canonical_key,json=canonicalKey,proto3" json:"canonical_key,omitempty"`
+	CanonicalHash string `protobuf:"bytes,8,opt,name=canonical_hash,json=canonicalHash,proto3" json:"canonical_hash,omitempty"`
+	// formatted is the address in the requested format.
+	Formatted     *FormattedAddress `protobuf:"bytes,9,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Address) GetStreetAddress() string {
+	if x != nil {
+		return x.StreetAddress
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+func (x *Address) GetFormattedAddress() string {
+	if x != nil {
+		return x.FormattedAddress
+	}
+	return ""
+}
+
+func (x *Address) GetCanonicalKey() string {
+	if x != nil {
+		return x.CanonicalKey
+	}
+	return ""
+}
+
+func (x *Address) GetCanonicalHash() string {
+	if x != nil {
+		return x.CanonicalHash
+	}
+	return ""
+}
+
+func (x *Address) GetFormatted() *FormattedAddress {
+	if x != nil {
+		return x.Formatted
+	}
+	return nil
+}
+
+// FormattedAddress is an address rendered in a requested format.
+type FormattedAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	MaxLineLength int32                  `protobuf:"varint,2,opt,name=max_line_length,json=maxLineLength,proto3" json:"max_line_length,omitempty"`
+	Lines         []string               `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// abbreviated reports that a line was shortened to fit; truncated that one was still cut.
+	Abbreviated   bool `protobuf:"varint,5,opt,name=abbreviated,proto3" json:"abbreviated,omitempty"`
+	Truncated     bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormattedAddress) Reset() {
+	*x = FormattedAddress{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormattedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormattedAddress) ProtoMessage() {}
+
+func (x *FormattedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormattedAddress.ProtoReflect.Descriptor instead.
+func (*FormattedAddress) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FormattedAddress) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *FormattedAddress) GetMaxLineLength() int32 {
+	if x != nil {
+		return x.MaxLineLength
+	}
+	return 0
+}
+
+func (x *FormattedAddress) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *FormattedAddress) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *FormattedAddress) GetAbbreviated() bool {
+	if x != nil {
+		return x.Abbreviated
+	}
+	return false
+}
+
+func (x *FormattedAddress) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Component locates a normalized component in the submitted address. start and
+// end are character offsets into the raw input; end is exclusive.
+type Component struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Raw           string                 `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Component) Reset() {
+	*x = Component{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Component) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Component) ProtoMessage() {}
+
+func (x *Component) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Component.ProtoReflect.Descriptor instead.
+func (*Component) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Component) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Component) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *Component) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Component) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Correction is one change normalization made to the input. type is "whitespace",
+// "capitalization", "state_name" or "dropped"; component is empty for whitespace.
+type Correction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Original      string                 `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"`
+	Corrected     string                 `protobuf:"bytes,3,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Correction) Reset() {
+	*x = Correction{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Correction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Correction) ProtoMessage() {}
+
+func (x *Correction) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Correction.ProtoReflect.Descriptor instead.
+func (*Correction) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Correction) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Correction) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *Correction) GetCorrected() string {
+	if x != nil {
+		return x.Corrected
+	}
+	return ""
+}
+
+func (x *Correction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Correction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Warning is a non-fatal issue with a validated address. code is an entry of the
+// error code catalog at docs_url; field names the component it concerns.
+type Warning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DocsUrl       string                 `protobuf:"bytes,2,opt,name=docs_url,json=docsUrl,proto3" json:"docs_url,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warning) Reset() {
+	*x = Warning{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warning) ProtoMessage() {}
+
+func (x *Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Warning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warning) GetDocsUrl() string {
+	if x != nil {
+		return x.DocsUrl
+	}
+	return ""
+}
+
+func (x *Warning) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Decision is one step of an explained validation. stage is "parse", "reference",
+// "policy", "confidence" or "standardize"; rule names the rule that fired.
+type Decision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Rule          string                 `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Component     string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Decision) Reset() {
+	*x = Decision{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decision) ProtoMessage() {}
+
+func (x *Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decision.ProtoReflect.Descriptor instead.
+func (*Decision) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Decision) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *Decision) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Decision) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Decision) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Decision) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// Confidence scores each component between 0 and 1.
+type Confidence struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StateConfidence  string                 `protobuf:"bytes,1,opt,name=state_confidence,json=stateConfidence,proto3" json:"state_confidence,omitempty"`
+	CityConfidence   string                 `protobuf:"bytes,2,opt,name=city_confidence,json=cityConfidence,proto3" json:"city_confidence,omitempty"`
+	PostalConfidence string                 `protobuf:"bytes,3,opt,name=postal_confidence,json=postalConfidence,proto3" json:"postal_confidence,omitempty"`
+	StreetScore      float64                `protobuf:"fixed64,4,opt,name=street_score,json=streetScore,proto3" json:"street_score,omitempty"`
+	CityScore        float64                `protobuf:"fixed64,5,opt,name=city_score,json=cityScore,proto3" json:"city_score,omitempty"`
+	StateScore       float64                `protobuf:"fixed64,6,opt,name=state_score,json=stateScore,proto3" json:"state_score,omitempty"`
+	PostalScore      float64                `protobuf:"fixed64,7,opt,name=postal_score,json=postalScore,proto3" json:"postal_score,omitempty"`
+	Overall          float64                `protobuf:"fixed64,8,opt,name=overall,proto3" json:"overall,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Confidence) Reset() {
+	*x = Confidence{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Confidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Confidence) ProtoMessage() {}
+
+func (x *Confidence) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Confidence.ProtoReflect.Descriptor instead.
+func (*Confidence) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Confidence) GetStateConfidence() string {
+	if x != nil {
+		return x.StateConfidence
+	}
+	return ""
+}
+
+func (x *Confidence) GetCityConfidence() string {
+	if x != nil {
+		return x.CityConfidence
+	}
+	return ""
+}
+
+func (x *Confidence) GetPostalConfidence() string {
+	if x != nil {
+		return x.PostalConfidence
+	}
+	return ""
+}
+
+func (x *Confidence) GetStreetScore() float64 {
+	if x != nil {
+		return x.StreetScore
+	}
+	return 0
+}
+
+func (x *Confidence) GetCityScore() float64 {
+	if x != nil {
+		return x.CityScore
+	}
+	return 0
+}
+
+func (x *Confidence) GetStateScore() float64 {
+	if x != nil {
+		return x.StateScore
+	}
+	return 0
+}
+
+func (x *Confidence) GetPostalScore() float64 {
+	if x != nil {
+		return x.PostalScore
+	}
+	return 0
+}
+
+func (x *Confidence) GetOverall() float64 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+// ValidateResult is the outcome of validating one address of a batch or stream.
+type ValidateResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*ValidateResult_Response
+	//	*ValidateResult_Error
+	Outcome       isValidateResult_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResult) Reset() {
+	*x = ValidateResult{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResult) ProtoMessage() {}
+
+func (x *ValidateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResult.ProtoReflect.Descriptor instead.
+func (*ValidateResult) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateResult) GetOutcome() isValidateResult_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *ValidateResult) GetResponse() *ValidateResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*ValidateResult_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *ValidateResult) GetError() *status.Status {
+	if x != nil {
+		if x, ok := x.Outcome.(*ValidateResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isValidateResult_Outcome interface {
+	isValidateResult_Outcome()
+}
+
+type ValidateResult_Response struct {
+	Response *ValidateResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ValidateResult_Error struct {
+	// error is the status Validate would have failed with.
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ValidateResult_Response) isValidateResult_Outcome() {}
+
+func (*ValidateResult_Error) isValidateResult_Outcome() {}
+
+type ValidateBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*ValidateRequest     `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateBatchRequest) Reset() {
+	*x = ValidateBatchRequest{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateBatchRequest) ProtoMessage() {}
+
+func (x *ValidateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateBatchRequest.ProtoReflect.Descriptor instead.
+func (*ValidateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateBatchRequest) GetRequests() []*ValidateRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ValidateBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results[i] answers requests[i].
+	Results       []*ValidateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateBatchResponse) Reset() {
+	*x = ValidateBatchResponse{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateBatchResponse) ProtoMessage() {}
+
+func (x *ValidateBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateBatchResponse.ProtoReflect.Descriptor instead.
+func (*ValidateBatchResponse) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateBatchResponse) GetResults() []*ValidateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ValidateStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is echoed on the request's result.
+	Id            string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Request       *ValidateRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateStreamRequest) Reset() {
+	*x = ValidateStreamRequest{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStreamRequest) ProtoMessage() {}
+
+func (x *ValidateStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStreamRequest.ProtoReflect.Descriptor instead.
+func (*ValidateStreamRequest) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidateStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ValidateStreamRequest) GetRequest() *ValidateRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ValidateStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is the 1-based position of the request in the stream.
+	Sequence      int64           `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Result        *ValidateResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateStreamResponse) Reset() {
+	*x = ValidateStreamResponse{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStreamResponse) ProtoMessage() {}
+
+func (x *ValidateStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStreamResponse.ProtoReflect.Descriptor instead.
+func (*ValidateStreamResponse) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ValidateStreamResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ValidateStreamResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ValidateStreamResponse) GetResult() *ValidateResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// CompareRequest mirrors the JSON body of POST /api/v1/compare. The options apply
+// to both addresses.
+type CompareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressA      string                 `protobuf:"bytes,1,opt,name=address_a,json=addressA,proto3" json:"address_a,omitempty"`
+	AddressB      string                 `protobuf:"bytes,2,opt,name=address_b,json=addressB,proto3" json:"address_b,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Strictness    string                 `protobuf:"bytes,4,opt,name=strictness,proto3" json:"strictness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRequest) ProtoMessage() {}
+
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompareRequest) GetAddressA() string {
+	if x != nil {
+		return x.AddressA
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetAddressB() string {
+	if x != nil {
+		return x.AddressB
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetStrictness() string {
+	if x != nil {
+		return x.Strictness
+	}
+	return ""
+}
+
+// CompareResponse reports how alike two addresses are.
+type CompareResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// verdict is "exact", "same_building" (the units differ), "same_street" or "different".
+	Verdict       string                          `protobuf:"bytes,1,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	Similarity    float64                         `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	AddressA      *Address                        `protobuf:"bytes,3,opt,name=address_a,json=addressA,proto3" json:"address_a,omitempty"`
+	AddressB      *Address                        `protobuf:"bytes,4,opt,name=address_b,json=addressB,proto3" json:"address_b,omitempty"`
+	Components    map[string]*ComponentComparison `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Message       string                          `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareResponse) Reset() {
+	*x = CompareResponse{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareResponse) ProtoMessage() {}
+
+func (x *CompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
+func (*CompareResponse) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompareResponse) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *CompareResponse) GetAddressA() *Address {
+	if x != nil {
+		return x.AddressA
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetAddressB() *Address {
+	if x != nil {
+		return x.AddressB
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetComponents() map[string]*ComponentComparison {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ComponentComparison compares one component of the two addresses, in USPS form.
+type ComponentComparison struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	A     string                 `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B     string                 `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	// result is "match", "different" or "missing".
+	Result        string  `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Similarity    float64 `protobuf:"fixed64,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentComparison) Reset() {
+	*x = ComponentComparison{}
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentComparison) ProtoMessage() {}
+
+func (x *ComponentComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_addressvalidation_v1_address_validation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentComparison.ProtoReflect.Descriptor instead.
+func (*ComponentComparison) Descriptor() ([]byte, []int) {
+	return file_addressvalidation_v1_address_validation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ComponentComparison) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *ComponentComparison) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+func (x *ComponentComparison) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ComponentComparison) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+var File_addressvalidation_v1_address_validation_proto protoreflect.FileDescriptor
+
+const file_addressvalidation_v1_address_validation_proto_rawDesc = "" +
+	"\n" +
+	"-addressvalidation/v1/address_validation.proto\x12\x14addressvalidation.v1\x1a\x17google/rpc/status.proto\"\x8f\x02\n" +
+	"\x0fValidateRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12-\n" +
+	"\x12include_components\x18\x02 \x01(\bR\x11includeComponents\x12%\n" +
+	"\x0emin_confidence\x18\x03 \x01(\x01R\rminConfidence\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x1e\n" +
+	"\n" +
+	"strictness\x18\x05 \x01(\tR\n" +
+	"strictness\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\x12&\n" +
+	"\x0fmax_line_length\x18\a \x01(\x05R\rmaxLineLength\x12\x18\n" +
+	"\aexplain\x18\b \x01(\bR\aexplain\"\xd2\x05\n" +
+	"\x10ValidateResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"strictness\x18\x02 \x01(\tR\n" +
+	"strictness\x127\n" +
+	"\aaddress\x18\x03 \x01(\v2\x1d.addressvalidation.v1.AddressR\aaddress\x12=\n" +
+	"\n" +
+	"candidates\x18\x04 \x03(\v2\x1d.addressvalidation.v1.AddressR\n" +
+	"candidates\x12@\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\v2 .addressvalidation.v1.ConfidenceR\n" +
+	"confidence\x12/\n" +
+	"\x13corrections_applied\x18\x06 \x03(\tR\x12correctionsApplied\x12V\n" +
+	"\n" +
+	"components\x18\a \x03(\v26.addressvalidation.v1.ValidateResponse.ComponentsEntryR\n" +
+	"components\x12\x14\n" +
+	"\x05cache\x18\b \x01(\tR\x05cache\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x124\n" +
+	"\x05trace\x18\n" +
+	" \x03(\v2\x1e.addressvalidation.v1.DecisionR\x05trace\x12B\n" +
+	"\vcorrections\x18\v \x03(\v2 .addressvalidation.v1.CorrectionR\vcorrections\x129\n" +
+	"\bwarnings\x18\f \x03(\v2\x1d.addressvalidation.v1.WarningR\bwarnings\x1a^\n" +
+	"\x0fComponentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.addressvalidation.v1.ComponentR\x05value:\x028\x01\"\xdd\x02\n" +
+	"\aAddress\x12%\n" +
+	"\x0estreet_address\x18\x01 \x01(\tR\rstreetAddress\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\faddress_type\x18\x05 \x01(\tR\vaddressType\x12+\n" +
+	"\x11formatted_address\x18\x06 \x01(\tR\x10formattedAddress\x12#\n" +
+	"\rcanonical_key\x18\a \x01(\tR\fcanonicalKey\x12%\n" +
+	"\x0ecanonical_hash\x18\b \x01(\tR\rcanonicalHash\x12D\n" +
+	"\tformatted\x18\t \x01(\v2&.addressvalidation.v1.FormattedAddressR\tformatted\"\xbc\x01\n" +
+	"\x10FormattedAddress\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12&\n" +
+	"\x0fmax_line_length\x18\x02 \x01(\x05R\rmaxLineLength\x12\x14\n" +
+	"\x05lines\x18\x03 \x03(\tR\x05lines\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12 \n" +
+	"\vabbreviated\x18\x05 \x01(\bR\vabbreviated\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\"[\n" +
+	"\tComponent\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\tR\x03raw\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\"\x90\x01\n" +
+	"\n" +
+	"Correction\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12\x1a\n" +
+	"\boriginal\x18\x02 \x01(\tR\boriginal\x12\x1c\n" +
+	"\tcorrected\x18\x03 \x01(\tR\tcorrected\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"h\n" +
+	"\aWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bdocs_url\x18\x02 \x01(\tR\adocsUrl\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x80\x01\n" +
+	"\bDecision\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\x12\x1c\n" +
+	"\tcomponent\x18\x03 \x01(\tR\tcomponent\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"\xad\x02\n" +
+	"\n" +
+	"Confidence\x12)\n" +
+	"\x10state_confidence\x18\x01 \x01(\tR\x0fstateConfidence\x12'\n" +
+	"\x0fcity_confidence\x18\x02 \x01(\tR\x0ecityConfidence\x12+\n" +
+	"\x11postal_confidence\x18\x03 \x01(\tR\x10postalConfidence\x12!\n" +
+	"\fstreet_score\x18\x04 \x01(\x01R\vstreetScore\x12\x1d\n" +
+	"\n" +
+	"city_score\x18\x05 \x01(\x01R\tcityScore\x12\x1f\n" +
+	"\vstate_score\x18\x06 \x01(\x01R\n" +
+	"stateScore\x12!\n" +
+	"\fpostal_score\x18\a \x01(\x01R\vpostalScore\x12\x18\n" +
+	"\aoverall\x18\b \x01(\x01R\aoverall\"\x8d\x01\n" +
+	"\x0eValidateResult\x12D\n" +
+	"\bresponse\x18\x01 \x01(\v2&.addressvalidation.v1.ValidateResponseH\x00R\bresponse\x12*\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x00R\x05errorB\t\n" +
+	"\aoutcome\"Y\n" +
+	"\x14ValidateBatchRequest\x12A\n" +
+	"\brequests\x18\x01 \x03(\v2%.addressvalidation.v1.ValidateRequestR\brequests\"W\n" +
+	"\x15ValidateBatchResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.addressvalidation.v1.ValidateResultR\aresults\"h\n" +
+	"\x15ValidateStreamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\arequest\x18\x02 \x01(\v2%.addressvalidation.v1.ValidateRequestR\arequest\"\x82\x01\n" +
+	"\x16ValidateStreamResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12<\n" +
+	"\x06result\x18\x03 \x01(\v2$.addressvalidation.v1.ValidateResultR\x06result\"~\n" +
+	"\x0eCompareRequest\x12\x1b\n" +
+	"\taddress_a\x18\x01 \x01(\tR\baddressA\x12\x1b\n" +
+	"\taddress_b\x18\x02 \x01(\tR\baddressB\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1e\n" +
+	"\n" +
+	"strictness\x18\x04 \x01(\tR\n" +
+	"strictness\"\x9e\x03\n" +
+	"\x0fCompareResponse\x12\x18\n" +
+	"\averdict\x18\x01 \x01(\tR\averdict\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12:\n" +
+	"\taddress_a\x18\x03 \x01(\v2\x1d.addressvalidation.v1.AddressR\baddressA\x12:\n" +
+	"\taddress_b\x18\x04 \x01(\v2\x1d.addressvalidation.v1.AddressR\baddressB\x12U\n" +
+	"\n" +
+	"components\x18\x05 \x03(\v25.addressvalidation.v1.CompareResponse.ComponentsEntryR\n" +
+	"components\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x1ah\n" +
+	"\x0fComponentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).addressvalidation.v1.ComponentComparisonR\x05value:\x028\x01\"i\n" +
+	"\x13ComponentComparison\x12\f\n" +
+	"\x01a\x18\x01 \x01(\tR\x01a\x12\f\n" +
+	"\x01b\x18\x02 \x01(\tR\x01b\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x04 \x01(\x01R\n" +
+	"similarity2\xa8\x03\n" +
+	"\x18AddressValidationService\x12Y\n" +
+	"\bValidate\x12%.addressvalidation.v1.ValidateRequest\x1a&.addressvalidation.v1.ValidateResponse\x12h\n" +
+	"\rValidateBatch\x12*.addressvalidation.v1.ValidateBatchRequest\x1a+.addressvalidation.v1.ValidateBatchResponse\x12o\n" +
+	"\x0eValidateStream\x12+.addressvalidation.v1.ValidateStreamRequest\x1a,.addressvalidation.v1.ValidateStreamResponse(\x010\x01\x12V\n" +
+	"\aCompare\x12$.addressvalidation.v1.CompareRequest\x1a%.addressvalidation.v1.CompareResponseBsZqgithub.com/williandandrade/address-validation-service/internal/api/proto/addressvalidation/v1;addressvalidationv1b\x06proto3"
+
+var (
+	file_addressvalidation_v1_address_validation_proto_rawDescOnce sync.Once
+	file_addressvalidation_v1_address_validation_proto_rawDescData []byte
+)
+
+func file_addressvalidation_v1_address_validation_proto_rawDescGZIP() []byte {
+	file_addressvalidation_v1_address_validation_proto_rawDescOnce.Do(func() {
+		file_addressvalidation_v1_address_validation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_addressvalidation_v1_address_validation_proto_rawDesc), len(file_addressvalidation_v1_address_validation_proto_rawDesc)))
+	})
+	return file_addressvalidation_v1_address_validation_proto_rawDescData
+}
+
+var file_addressvalidation_v1_address_validation_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_addressvalidation_v1_address_validation_proto_goTypes = []any{
+	(*ValidateRequest)(nil),        // 0: addressvalidation.v1.ValidateRequest
+	(*ValidateResponse)(nil),       // 1: addressvalidation.v1.ValidateResponse
+	(*Address)(nil),                // 2: addressvalidation.v1.Address
+	(*FormattedAddress)(nil),       // 3: addressvalidation.v1.FormattedAddress
+	(*Component)(nil),              // 4: addressvalidation.v1.Component
+	(*Correction)(nil),             // 5: addressvalidation.v1.Correction
+	(*Warning)(nil),                // 6: addressvalidation.v1.Warning
+	(*Decision)(nil),               // 7: addressvalidation.v1.Decision
+	(*Confidence)(nil),             // 8: addressvalidation.v1.Confidence
+	(*ValidateResult)(nil),         // 9: addressvalidation.v1.ValidateResult
+	(*ValidateBatchRequest)(nil),   // 10: addressvalidation.v1.ValidateBatchRequest
+	(*ValidateBatchResponse)(nil),  // 11: addressvalidation.v1.ValidateBatchResponse
+	(*ValidateStreamRequest)(nil),  // 12: addressvalidation.v1.ValidateStreamRequest
+	(*ValidateStreamResponse)(nil), // 13: addressvalidation.v1.ValidateStreamResponse
+	(*CompareRequest)(nil),         // 14: addressvalidation.v1.CompareRequest
+	(*CompareResponse)(nil),        // 15: addressvalidation.v1.CompareResponse
+	(*ComponentComparison)(nil),    // 16: addressvalidation.v1.ComponentComparison
+	nil,                            // 17: addressvalidation.v1.ValidateResponse.ComponentsEntry
+	nil,                            // 18: addressvalidation.v1.CompareResponse.ComponentsEntry
+	(*status.Status)(nil),          // 19: google.rpc.Status
+}
+var file_addressvalidation_v1_address_validation_proto_depIdxs = []int32{
+	2,  // 0: addressvalidation.v1.ValidateResponse.address:type_name -> addressvalidation.v1.Address
+	2,  // 1: addressvalidation.v1.ValidateResponse.candidates:type_name -> addressvalidation.v1.Address
+	8,  // 2: addressvalidation.v1.ValidateResponse.confidence:type_name -> addressvalidation.v1.Confidence
+	17, // 3: addressvalidation.v1.ValidateResponse.components:type_name -> addressvalidation.v1.ValidateResponse.ComponentsEntry
+	7,  // 4: addressvalidation.v1.ValidateResponse.trace:type_name -> addressvalidation.v1.Decision
+	5,  // 5: addressvalidation.v1.ValidateResponse.corrections:type_name -> addressvalidation.v1.Correction
+	6,  // 6: addressvalidation.v1.ValidateResponse.warnings:type_name -> addressvalidation.v1.Warning
+	3,  // 7: addressvalidation.v1.Address.formatted:type_name -> addressvalidation.v1.FormattedAddress
+	1,  // 8: addressvalidation.v1.ValidateResult.response:type_name -> addressvalidation.v1.ValidateResponse
+	19, // 9: addressvalidation.v1.ValidateResult.error:type_name -> google.rpc.Status
+	0,  // 10: addressvalidation.v1.ValidateBatchRequest.requests:type_name -> addressvalidation.v1.ValidateRequest
+	9,  // 11: addressvalidation.v1.ValidateBatchResponse.results:type_name -> addressvalidation.v1.ValidateResult
+	0,  // 12: addressvalidation.v1.ValidateStreamRequest.request:type_name -> addressvalidation.v1.ValidateRequest
+	9,  // 13: addressvalidation.v1.ValidateStreamResponse.result:type_name -> addressvalidation.v1.ValidateResult
+	2,  // 14: addressvalidation.v1.CompareResponse.address_a:type_name -> addressvalidation.v1.Address
+	2,  // 15: addressvalidation.v1.CompareResponse.address_b:type_name -> addressvalidation.v1.Address
+	18, // 16: addressvalidation.v1.CompareResponse.components:type_name -> addressvalidation.v1.CompareResponse.ComponentsEntry
+	4,  // 17: addressvalidation.v1.ValidateResponse.ComponentsEntry.value:type_name -> addressvalidation.v1.Component
+	16, // 18: addressvalidation.v1.CompareResponse.ComponentsEntry.value:type_name -> addressvalidation.v1.ComponentComparison
+	0,  // 19: addressvalidation.v1.AddressValidationService.Validate:input_type -> addressvalidation.v1.ValidateRequest
+	10, // 20: addressvalidation.v1.AddressValidationService.ValidateBatch:input_type -> addressvalidation.v1.ValidateBatchRequest
+	12, // 21: addressvalidation.v1.AddressValidationService.ValidateStream:input_type -> addressvalidation.v1.ValidateStreamRequest
+	14, // 22: addressvalidation.v1.AddressValidationService.Compare:input_type -> addressvalidation.v1.CompareRequest
+	1,  // 23: addressvalidation.v1.AddressValidationService.Validate:output_type -> addressvalidation.v1.ValidateResponse
+	11, // 24: addressvalidation.v1.AddressValidationService.ValidateBatch:output_type -> addressvalidation.v1.ValidateBatchResponse
+	13, // 25: addressvalidation.v1.AddressValidationService.ValidateStream:output_type -> addressvalidation.v1.ValidateStreamResponse
+	15, // 26: addressvalidation.v1.AddressValidationService.Compare:output_type -> addressvalidation.v1.CompareResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_addressvalidation_v1_address_validation_proto_init() }
+func file_addressvalidation_v1_address_validation_proto_init() {
+	if File_addressvalidation_v1_address_validation_proto != nil {
+		return
+	}
+	file_addressvalidation_v1_address_validation_proto_msgTypes[9].OneofWrappers = []any{
+		(*ValidateResult_Response)(nil),
+		(*ValidateResult_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_addressvalidation_v1_address_validation_proto_rawDesc), len(file_addressvalidation_v1_address_validation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_addressvalidation_v1_address_validation_proto_goTypes,
+		DependencyIndexes: file_addressvalidation_v1_address_validation_proto_depIdxs,
+		MessageInfos:      file_addressvalidation_v1_address_validation_proto_msgTypes,
+	}.Build()
+	File_addressvalidation_v1_address_validation_proto = out.File
+	file_addressvalidation_v1_address_validation_proto_goTypes = nil
+	file_addressvalidation_v1_address_validation_proto_depIdxs = nil
+}
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: addressvalidation/v1/address_validation.proto
+
+package addressvalidationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AddressValidationService_Validate_FullMethodName       = "/addressvalidation.v1.AddressValidationService/Validate"
+	AddressValidationService_ValidateBatch_FullMethodName  = "/addressvalidation.v1.AddressValidationService/ValidateBatch"
+	AddressValidationService_ValidateStream_FullMethodName = "/addressvalidation.v1.AddressValidationService/ValidateStream"
+	AddressValidationService_Compare_FullMethodName        = "/addressvalidation.v1.AddressValidationService/Compare"
+)
+
+// AddressValidationServiceClient is the client API for AddressValidationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AddressValidationService is the gRPC interface to the REST API's validate and
+// compare endpoints. It runs the same usecases, so requests, responses and
+// options mean the same thing. Failures are returned as gRPC status codes: a
+// rejected request or an address that cannot be normalized is INVALID_ARGUMENT
+// with a google.rpc.BadRequest detail naming the field, a timeout is
+// DEADLINE_EXCEEDED, and anything else is INTERNAL.
+type AddressValidationServiceClient interface {
+	// Validate normalizes one address.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// ValidateBatch validates up to 1000 addresses. A failed address does not fail
+	// the batch; each result carries its own response or status.
+	ValidateBatch(ctx context.Context, in *ValidateBatchRequest, opts ...grpc.CallOption) (*ValidateBatchResponse, error)
+	// ValidateStream validates requests as they arrive and sends each result as
+	// soon as it is ready, so results can arrive out of order. The stream ends
+	// once the client closes its side and every result has been sent.
+	ValidateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValidateStreamRequest, ValidateStreamResponse], error)
+	// Compare reports whether two addresses describe the same place.
+	Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
+}
+
+type addressValidationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAddressValidationServiceClient(cc grpc.ClientConnInterface) AddressValidationServiceClient {
+	return &addressValidationServiceClient{cc}
+}
+
+func (c *addressValidationServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, AddressValidationService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressValidationServiceClient) ValidateBatch(ctx context.Context, in *ValidateBatchRequest, opts ...grpc.CallOption) (*ValidateBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateBatchResponse)
+	err := c.cc.Invoke(ctx, AddressValidationService_ValidateBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressValidationServiceClient) ValidateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValidateStreamRequest, ValidateStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AddressValidationService_ServiceDesc.Streams[0], AddressValidationService_ValidateStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ValidateStreamRequest, ValidateStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AddressValidationService_ValidateStreamClient = grpc.BidiStreamingClient[ValidateStreamRequest, ValidateStreamResponse]
+
+func (c *addressValidationServiceClient) Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareResponse)
+	err := c.cc.Invoke(ctx, AddressValidationService_Compare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddressValidationServiceServer is the server API for AddressValidationService service.
+// All implementations must embed UnimplementedAddressValidationServiceServer
+// for forward compatibility.
+//
+// AddressValidationService is the gRPC interface to the REST API's validate and
+// compare endpoints. It runs the same usecases, so requests, responses and
+// options mean the same thing. Failures are returned as gRPC status codes: a
+// rejected request or an address that cannot be normalized is INVALID_ARGUMENT
+// with a google.rpc.BadRequest detail naming the field, a timeout is
+// DEADLINE_EXCEEDED, and anything else is INTERNAL.
+type AddressValidationServiceServer interface {
+	// Validate normalizes one address.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// ValidateBatch validates up to 1000 addresses. A failed address does not fail
+	// the batch; each result carries its own response or status.
+	ValidateBatch(context.Context, *ValidateBatchRequest) (*ValidateBatchResponse, error)
+	// ValidateStream validates requests as they arrive and sends each result as
+	// soon as it is ready, so results can arrive out of order. The stream ends
+	// once the client closes its side and every result has been sent.
+	ValidateStream(grpc.BidiStreamingServer[ValidateStreamRequest, ValidateStreamResponse]) error
+	// Compare reports whether two addresses describe the same place.
+	Compare(context.Context, *CompareRequest) (*CompareResponse, error)
+	mustEmbedUnimplementedAddressValidationServiceServer()
+}
+
+// UnimplementedAddressValidationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAddressValidationServiceServer struct{}
+
+func (UnimplementedAddressValidationServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedAddressValidationServiceServer) ValidateBatch(context.Context, *ValidateBatchRequest) (*ValidateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateBatch not implemented")
+}
+func (UnimplementedAddressValidationServiceServer) ValidateStream(grpc.BidiStreamingServer[ValidateStreamRequest, ValidateStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateStream not implemented")
+}
+func (UnimplementedAddressValidationServiceServer) Compare(context.Context, *CompareRequest) (*CompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
+func (UnimplementedAddressValidationServiceServer) mustEmbedUnimplementedAddressValidationServiceServer() {
+}
+func (UnimplementedAddressValidationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAddressValidationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AddressValidationServiceServer will
+// result in compilation errors.
+type UnsafeAddressValidationServiceServer interface {
+	mustEmbedUnimplementedAddressValidationServiceServer()
+}
+
+func RegisterAddressValidationServiceServer(s grpc.ServiceRegistrar, srv AddressValidationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAddressValidationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AddressValidationService_ServiceDesc, srv)
+}
+
+func _AddressValidationService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressValidationServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressValidationService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressValidationServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressValidationService_ValidateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressValidationServiceServer).ValidateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressValidationService_ValidateBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressValidationServiceServer).ValidateBatch(ctx, req.(*ValidateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressValidationService_ValidateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AddressValidationServiceServer).ValidateStream(&grpc.GenericServerStream[ValidateStreamRequest, ValidateStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AddressValidationService_ValidateStreamServer = grpc.BidiStreamingServer[ValidateStreamRequest, ValidateStreamResponse]
+
+func _AddressValidationService_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressValidationServiceServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressValidationService_Compare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressValidationServiceServer).Compare(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AddressValidationService_ServiceDesc is the grpc.ServiceDesc for AddressValidationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AddressValidationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "addressvalidation.v1.AddressValidationService",
+	HandlerType: (*AddressValidationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _AddressValidationService_Validate_Handler,
+		},
+		{
+			MethodName: "ValidateBatch",
+			Handler:    _AddressValidationService_ValidateBatch_Handler,
+		},
+		{
+			MethodName: "Compare",
+			Handler:    _AddressValidationService_Compare_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateStream",
+			Handler:       _AddressValidationService_ValidateStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "addressvalidation/v1/address_validation.proto",
+}
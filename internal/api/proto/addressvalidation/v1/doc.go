@@ -0,0 +1,5 @@
+// Package addressvalidationv1 holds the protobuf messages and gRPC service generated
+// from address_validation.proto. Regenerate them with go generate after editing it.
+package addressvalidationv1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative addressvalidation/v1/address_validation.proto
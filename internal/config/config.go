@@ -0,0 +1,96 @@
+// Package config reads the settings shared by the server and the worker from GoFr's
+// configuration. Each reader exits through the app's logger on a malformed value, so
+// a bad setting stops the process at startup rather than at first use.
+package config
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/rediscache"
+)
+
+// Duration reads a Go duration (e.g. "24h") from the environment, exiting on malformed values.
+func Duration(app *gofr.App, key, fallback string) time.Duration {
+	value := app.Config.GetOrDefault(key, fallback)
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		app.Logger().Fatalf("invalid %s %q: %v", key, value, err)
+	}
+	return d
+}
+
+// Int reads a non-negative integer from the environment, exiting on malformed values.
+func Int(app *gofr.App, key, fallback string) int {
+	value := app.Config.GetOrDefault(key, fallback)
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		app.Logger().Fatalf("invalid %s %q: must be a non-negative integer", key, value)
+	}
+	return n
+}
+
+// Bool reads a boolean (true, false, 1, 0, ...) from the environment, exiting on malformed values.
+func Bool(app *gofr.App, key, fallback string) bool {
+	value := app.Config.GetOrDefault(key, fallback)
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		app.Logger().Fatalf("invalid %s %q: must be true or false", key, value)
+	}
+	return b
+}
+
+// Prefixes reads a comma-separated list of CIDR prefixes or single IP addresses
+// from the environment, exiting on malformed values. Unset means none.
+func Prefixes(app *gofr.App, key string) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, value := range strings.Split(app.Config.Get(key), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(value); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			app.Logger().Fatalf("invalid %s entry %q: use a CIDR prefix or an IP address", key, value)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes
+}
+
+// Strictness reads DEFAULT_STRICTNESS, the strictness of requests that do not set one.
+func Strictness(app *gofr.App) entity.Strictness {
+	strictness := entity.Strictness(app.Config.GetOrDefault("DEFAULT_STRICTNESS", string(entity.StrictnessStandard)))
+	if !strictness.IsValid() {
+		app.Logger().Fatalf("invalid DEFAULT_STRICTNESS %q: use strict, standard or lenient", strictness)
+	}
+	return strictness
+}
+
+// RedisStore returns a store bound to GoFr's Redis datasource once the app starts.
+// requiredBy names what needs Redis, for the startup error.
+func RedisStore(app *gofr.App, requiredBy string) *rediscache.Store {
+	if app.Config.Get("REDIS_HOST") == "" {
+		app.Logger().Fatalf("%s requires REDIS_HOST", requiredBy)
+	}
+
+	store := rediscache.NewStore()
+	app.OnStart(func(ctx *gofr.Context) error {
+		store.SetClient(ctx.Redis)
+		return nil
+	})
+	return store
+}
@@ -1,28 +1,51 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
 )
 
 // Address represents a normalized, validated US address.
 type Address struct {
-	StreetAddress      string      `json:"street_address"`
-	City               string      `json:"city"`
-	State              string      `json:"state"`
-	PostalCode         string      `json:"postal_code"`
-	AddressType        string      `json:"address_type"`
-	FormattedAddress   string      `json:"formatted_address,omitempty"`
-	Confidence         *Confidence `json:"confidence,omitempty"`
-	CorrectionsApplied []string    `json:"corrections_applied,omitempty"`
+	StreetAddress    string      `json:"street_address"`
+	City             string      `json:"city"`
+	State            string      `json:"state"`
+	PostalCode       string      `json:"postal_code"`
+	AddressType      string      `json:"address_type"`
+	FormattedAddress string      `json:"formatted_address,omitempty"`
+	Confidence       *Confidence `json:"confidence,omitempty"`
+	// Corrections lists what normalization changed, in the order it was applied.
+	Corrections []Correction `json:"corrections,omitempty"`
+	// Components maps a component name to the span of the raw input it was parsed from.
+	Components map[string]TextSpan `json:"components,omitempty"`
+	// Evidence records how the parser arrived at each component value.
+	Evidence map[string]Evidence `json:"evidence,omitempty"`
 }
 
+// Component names used to key per-component metadata.
+const (
+	ComponentStreetAddress = "street_address"
+	ComponentCity          = "city"
+	ComponentState         = "state"
+	ComponentPostalCode    = "postal_code"
+)
+
 // Confidence tracks the source of each address component.
 type Confidence struct {
 	StateConfidence  string `json:"state_confidence"`
 	CityConfidence   string `json:"city_confidence"`
 	PostalConfidence string `json:"postal_confidence"`
+
+	// Numeric scores in [0, 1] derived from parser evidence; 0 means the component is absent.
+	StreetScore float64 `json:"street_score"`
+	CityScore   float64 `json:"city_score"`
+	StateScore  float64 `json:"state_score"`
+	PostalScore float64 `json:"postal_score"`
+	Overall     float64 `json:"overall"`
 }
 
 var zipRegex = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
@@ -42,8 +65,92 @@ var ValidUSStates = map[string]bool{
 	"WY": true,
 }
 
+// ValidationMode selects which components an address must have.
+type ValidationMode string
+
+const (
+	// ModeFull requires a deliverable address: at least 2 of street, city and state.
+	ModeFull ValidationMode = "full"
+	// ModeLocality requires city and state only ("Boise, ID").
+	ModeLocality ValidationMode = "locality"
+	// ModePostal requires a ZIP code; street, city and state are optional.
+	ModePostal ValidationMode = "postal"
+)
+
+// IsValid reports whether m is a known validation mode.
+func (m ValidationMode) IsValid() bool {
+	return m == ModeFull || m == ModeLocality || m == ModePostal
+}
+
+// Strictness selects how much the rules of a ValidationMode are tightened or relaxed.
+type Strictness string
+
+const (
+	// StrictnessStrict additionally requires a ZIP and house number (full mode) and rejects fuzzy fixes.
+	StrictnessStrict Strictness = "strict"
+	// StrictnessStandard applies the mode's rules as-is.
+	StrictnessStandard Strictness = "standard"
+	// StrictnessLenient accepts any address with at least one component, skipping format checks.
+	StrictnessLenient Strictness = "lenient"
+)
+
+// IsValid reports whether s is a known strictness level.
+func (s Strictness) IsValid() bool {
+	return s == StrictnessStrict || s == StrictnessStandard || s == StrictnessLenient
+}
+
+// ValidationPolicy combines the component requirements and their strictness.
+type ValidationPolicy struct {
+	Mode       ValidationMode
+	Strictness Strictness
+}
+
 // Validate checks that Address meets minimum requirements.
 func (a *Address) Validate() error {
+	return a.ValidateMode(ModeFull)
+}
+
+// ValidateMode checks that Address has the components required by mode.
+func (a *Address) ValidateMode(mode ValidationMode) error {
+	return a.ValidatePolicy(ValidationPolicy{Mode: mode, Strictness: StrictnessStandard})
+}
+
+// ValidatePolicy checks Address against the mode's requirements at the policy's strictness.
+// Every failed check is reported, joined with errors.Join, so a caller can fix them all
+// in one pass; each is a *domainerrors.ParsingError carrying the catalog code of the rule.
+func (a *Address) ValidatePolicy(policy ValidationPolicy) error {
+	if policy.Strictness == StrictnessLenient {
+		if a.StreetAddress == "" && a.City == "" && a.State == "" && a.PostalCode == "" {
+			return policyError(domainerrors.CodeComponentsInsufficient, "address", "", "at least one address component must be present")
+		}
+		return nil
+	}
+
+	problems := a.validateMode(policy.Mode)
+	problems = append(problems, a.validateFormats()...)
+	if policy.Strictness == StrictnessStrict {
+		problems = append(problems, a.validateStrict(policy.Mode)...)
+	}
+	return errors.Join(problems...)
+}
+
+func (a *Address) validateMode(mode ValidationMode) []error {
+	switch mode {
+	case ModeLocality:
+		if a.City == "" || a.State == "" {
+			return []error{policyError(domainerrors.CodeComponentsInsufficient, "address", "", "city and state must be present")}
+		}
+	case ModePostal:
+		if a.PostalCode == "" {
+			return []error{policyError(domainerrors.CodeComponentsInsufficient, "address", "", "postal_code must be present")}
+		}
+	default:
+		return a.validateFull()
+	}
+	return nil
+}
+
+func (a *Address) validateFull() []error {
 	presentCount := 0
 	if a.StreetAddress != "" {
 		presentCount++
@@ -56,18 +163,69 @@ func (a *Address) Validate() error {
 	}
 
 	if presentCount < 2 {
-		return fmt.Errorf("at least 2 of street_address, city, state must be present")
+		return []error{policyError(domainerrors.CodeComponentsInsufficient, "address", "", "at least 2 of street_address, city, state must be present")}
+	}
+	return nil
+}
+
+func (a *Address) validateStrict(mode ValidationMode) []error {
+	var problems []error
+	if mode == ModeFull {
+		if a.PostalCode == "" {
+			problems = append(problems, policyError(domainerrors.CodeZIPRequired, ComponentPostalCode, "", "postal_code is required by strict validation"))
+		}
+		if a.StreetAddress == "" || a.StreetAddress[0] < '0' || a.StreetAddress[0] > '9' {
+			problems = append(problems, policyError(domainerrors.CodeHouseNumberMissing, ComponentStreetAddress, a.StreetAddress,
+				"street_address must start with a house number under strict validation"))
+		}
+	}
+
+	for _, name := range []string{ComponentStreetAddress, ComponentCity, ComponentState, ComponentPostalCode} {
+		if a.Evidence[name] == EvidenceFuzzy {
+			problems = append(problems, policyError(domainerrors.CodeFuzzyCorrection, name, "",
+				"%s needed a fuzzy correction, which strict validation does not allow", name))
+		}
 	}
+	return problems
+}
 
+func (a *Address) validateFormats() []error {
+	var problems []error
 	if a.State != "" && !ValidUSStates[strings.ToUpper(a.State)] {
-		return fmt.Errorf("invalid state code: %s", a.State)
+		problems = append(problems, policyError(domainerrors.CodeStateInvalid, ComponentState, a.State, "invalid state code: %s", a.State))
+	}
+
+	if a.PostalCode != "" && !IsValidPostalCode(a.PostalCode) {
+		problems = append(problems, policyError(domainerrors.CodeZIPFormat, ComponentPostalCode, a.PostalCode, "postal_code must be 5 or 9-digit format"))
 	}
+	return problems
+}
+
+// policySuggestions tell callers how to fix a failed policy check. Missing components
+// are left to the caller, which knows the mode it asked for.
+var policySuggestions = map[domainerrors.Code]string{
+	domainerrors.CodeStateInvalid:       "Use a 2-letter USPS state code such as IL or CA",
+	domainerrors.CodeZIPFormat:          "Use a 5-digit ZIP code or ZIP+4, e.g. 62701-1234",
+	domainerrors.CodeZIPRequired:        "Add the ZIP code, or use standard strictness",
+	domainerrors.CodeHouseNumberMissing: "Start the street with its house number, or use standard strictness",
+	domainerrors.CodeFuzzyCorrection:    "Give the state as its USPS code, or use standard strictness",
+}
 
-	if a.PostalCode != "" && !zipRegex.MatchString(a.PostalCode) {
-		return fmt.Errorf("postal_code must be 5 or 9-digit format")
+// policyError reports a failed policy check as a ParsingError on field: the component
+// that failed it, or "address" when the check is about the address as a whole.
+func policyError(code domainerrors.Code, field, value, format string, args ...any) error {
+	return &domainerrors.ParsingError{
+		Code:       code,
+		Field:      field,
+		Reason:     fmt.Sprintf(format, args...),
+		Value:      value,
+		Suggestion: policySuggestions[code],
 	}
+}
 
-	return nil
+// IsValidPostalCode reports whether zip is in 5-digit or ZIP+4 format.
+func IsValidPostalCode(zip string) bool {
+	return zipRegex.MatchString(zip)
 }
 
 // FormatAddress generates a human-readable formatted address string.
@@ -0,0 +1,288 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// AddressFormat selects how Format lays out an address.
+type AddressFormat string
+
+const (
+	// FormatSingleLine renders one comma-separated line, as FormatAddress does.
+	FormatSingleLine AddressFormat = "single_line"
+	// FormatMultiLine renders the delivery line and the "City, ST ZIP" line separately.
+	FormatMultiLine AddressFormat = "multi_line"
+	// FormatUSPSLabel renders the USPS two-line label: upper case, standard abbreviations, no punctuation.
+	FormatUSPSLabel AddressFormat = "usps_label"
+)
+
+// IsValid reports whether f is a known address format.
+func (f AddressFormat) IsValid() bool {
+	return f == FormatSingleLine || f == FormatMultiLine || f == FormatUSPSLabel
+}
+
+// Line length limits accepted by FormatOptions. Carriers commonly cap lines at 35 or 40 characters.
+const (
+	MinLineLength = 20
+	MaxLineLength = 200
+)
+
+// FormatOptions configures Format.
+type FormatOptions struct {
+	Format AddressFormat
+	// MaxLineLength caps every line in characters; zero means no limit.
+	MaxLineLength int
+}
+
+// FormattedLines is an address rendered by Format.
+type FormattedLines struct {
+	Lines []string
+	// Abbreviated reports that a line was shortened to fit MaxLineLength.
+	Abbreviated bool
+	// Truncated reports that a line still did not fit once abbreviated and was cut at a word boundary.
+	Truncated bool
+}
+
+// nameWordAbbreviations shortens common words in street and city names. They are
+// only applied when a line does not fit its limit.
+var nameWordAbbreviations = map[string]string{
+	"SAINT": "ST", "SAINTE": "STE", "FORT": "FT", "MOUNT": "MT", "MOUNTAIN": "MTN",
+	"JUNIOR": "JR", "DOCTOR": "DR", "GENERAL": "GEN", "PRESIDENT": "PRES",
+	"COUNTY": "CO", "ROUTE": "RTE", "JUNCTION": "JCT", "SPRINGS": "SPGS", "VILLAGE": "VLG",
+	"MEMORIAL": "MEML", "NATIONAL": "NATL", "INTERNATIONAL": "INTL",
+}
+
+// Format renders the address in the requested layout. With a MaxLineLength, a line
+// that does not fit is shortened one step at a time until it does: the street is
+// standardized with USPS abbreviations, the unit moves to its own line above the
+// delivery line (multi-line layouts only), words in the street and city names are
+// abbreviated, and the ZIP+4 drops to 5 digits. Only a line that still does not
+// fit is cut, between words. Shortened lines use the upper-case USPS form.
+func (a *Address) Format(opts FormatOptions) FormattedLines {
+	if opts.Format == FormatSingleLine {
+		return a.formatSingleLine(opts.MaxLineLength)
+	}
+
+	label := opts.Format == FormatUSPSLabel
+	var out FormattedLines
+	if a.StreetAddress != "" {
+		lines, abbreviated, truncated := fitVariants(a.deliveryVariants(label, true), opts.MaxLineLength)
+		out.Lines = append(out.Lines, lines...)
+		out.Abbreviated, out.Truncated = abbreviated, truncated
+	}
+
+	line, abbreviated, truncated := fitLastLine(a.lastLineVariants(label), opts.MaxLineLength)
+	if line != "" {
+		out.Lines = append(out.Lines, line)
+	}
+	out.Abbreviated = out.Abbreviated || abbreviated
+	out.Truncated = out.Truncated || truncated
+
+	return out
+}
+
+func (a *Address) formatSingleLine(limit int) FormattedLines {
+	deliveries := [][]string{{""}}
+	if a.StreetAddress != "" {
+		deliveries = a.deliveryVariants(false, false)
+	}
+	lastLines := a.lastLineVariants(false)
+
+	// Shorten the street first, then the last line, keeping the most shortened street.
+	var variants []string
+	for _, delivery := range deliveries {
+		variants = append(variants, joinLineParts(delivery[0], lastLines[0].String()))
+	}
+	street := deliveries[len(deliveries)-1][0]
+	for _, last := range lastLines[1:] {
+		variants = append(variants, joinLineParts(street, last.String()))
+	}
+
+	for i, line := range variants {
+		if fits(line, limit) {
+			return FormattedLines{Lines: []string{line}, Abbreviated: i > 0}
+		}
+	}
+
+	// Cut the street before the city, and never the state and ZIP, which route the mail.
+	last := lastLines[len(lastLines)-1]
+	if budget := limit - runeCount(joinLineParts("x", last.String())) + 1; street != "" && budget > 0 {
+		if cut := cutLine(street, budget); cut != "" {
+			return FormattedLines{Lines: []string{joinLineParts(cut, last.String())}, Abbreviated: true, Truncated: true}
+		}
+	}
+	line, _, _ := fitLastLine([]lastLine{{city: joinLineParts(street, last.city), stateZIP: last.stateZIP}}, limit)
+	return FormattedLines{Lines: []string{line}, Abbreviated: true, Truncated: true}
+}
+
+// deliveryVariants lists the delivery line from its natural form to its shortest.
+// With split, the unit may move to a line of its own above the delivery line.
+func (a *Address) deliveryVariants(label, split bool) [][]string {
+	std := ParseStreetLine(a.StreetAddress)
+	natural := a.StreetAddress
+	if label {
+		natural = std.String()
+	}
+
+	short := std
+	short.Name = abbreviateWords(std.Name)
+
+	variants := [][]string{{natural}, {std.String()}}
+	if !split || std.secondary() == "" {
+		return append(variants, []string{short.String()})
+	}
+
+	unit := std.secondary()
+	std.UnitType, std.Unit = "", ""
+	short.UnitType, short.Unit = "", ""
+	return append(variants, []string{unit, std.String()}, []string{unit, short.String()})
+}
+
+// lastLine is the city, state and ZIP line of an address.
+type lastLine struct {
+	city     string
+	stateZIP string
+	label    bool
+}
+
+// String renders "City, ST ZIP", or "CITY ST ZIP" on a label.
+func (l lastLine) String() string {
+	if l.label {
+		return joinNonEmpty(l.city, l.stateZIP)
+	}
+	return joinLineParts(l.city, l.stateZIP)
+}
+
+// lastLineVariants lists the last line from its natural form to its shortest.
+func (a *Address) lastLineVariants(label bool) []lastLine {
+	state := strings.ToUpper(strings.TrimSpace(a.State))
+	city, zip := a.City, a.PostalCode
+	if label {
+		city = labelText(city)
+	}
+
+	variants := []lastLine{
+		{city: city, stateZIP: joinNonEmpty(state, zip), label: label},
+		{city: abbreviateWords(city), stateZIP: joinNonEmpty(state, zip), label: label},
+	}
+	if len(zip) > 5 {
+		variants = append(variants, lastLine{city: abbreviateWords(city), stateZIP: joinNonEmpty(state, zip[:5]), label: label})
+	}
+	return variants
+}
+
+// fitLastLine returns the first last line that fits limit. When none does, the city
+// of the shortest is cut so the state and ZIP stay whole.
+func fitLastLine(variants []lastLine, limit int) (line string, abbreviated, truncated bool) {
+	for i, variant := range variants {
+		if line := variant.String(); fits(line, limit) {
+			return line, i > 0, false
+		}
+	}
+
+	last := variants[len(variants)-1]
+	withoutCity := last
+	withoutCity.city = ""
+	if budget := limit - runeCount(withoutCity.String()) - 2; budget > 0 {
+		last.city = cutLine(last.city, budget)
+	} else {
+		last.city = ""
+	}
+	return cutLine(last.String(), limit), true, true
+}
+
+// fitVariants returns the first variant whose lines all fit limit, or the last
+// variant with every line cut to fit.
+func fitVariants(variants [][]string, limit int) (lines []string, abbreviated, truncated bool) {
+	for i, variant := range variants {
+		if allFit(variant, limit) {
+			return variant, i > 0, false
+		}
+	}
+
+	last := variants[len(variants)-1]
+	lines = make([]string, len(last))
+	for i, line := range last {
+		lines[i] = cutLine(line, limit)
+	}
+	return lines, len(variants) > 1, true
+}
+
+func allFit(lines []string, limit int) bool {
+	for _, line := range lines {
+		if !fits(line, limit) {
+			return false
+		}
+	}
+	return true
+}
+
+func fits(line string, limit int) bool {
+	return limit <= 0 || runeCount(line) <= limit
+}
+
+func runeCount(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// cutLine keeps the words of line that fit limit. A first word longer than the limit is cut mid-word.
+func cutLine(line string, limit int) string {
+	if fits(line, limit) {
+		return line
+	}
+
+	var out string
+	for _, word := range strings.Fields(line) {
+		next := joinNonEmpty(out, word)
+		if !fits(next, limit) {
+			break
+		}
+		out = next
+	}
+	if out == "" {
+		out = string([]rune(line)[:limit])
+	}
+	return strings.TrimRight(out, ", ")
+}
+
+// abbreviateWords upper-cases s and abbreviates each word that has a USPS short form.
+func abbreviateWords(s string) string {
+	words := strings.Fields(labelText(s))
+	for i, word := range words {
+		for _, abbreviations := range []map[string]string{nameWordAbbreviations, directionalAbbreviations, streetSuffixAbbreviations} {
+			if abbr, ok := abbreviations[word]; ok {
+				words[i] = abbr
+				break
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// labelText upper-cases s for a USPS label: periods are dropped, and other punctuation
+// except '-', '#' and '/' becomes a space ("Coeur d'Alene" becomes "COEUR D ALENE").
+func labelText(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == '.':
+			return -1
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '#', r == '/':
+			return unicode.ToUpper(r)
+		}
+		return ' '
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// joinLineParts joins the non-empty parts with a comma, as FormatAddress does.
+func joinLineParts(parts ...string) string {
+	var out []string
+	for _, part := range parts {
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return strings.Join(out, ", ")
+}
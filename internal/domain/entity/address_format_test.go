@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress_Format(t *testing.T) {
+	addr := &Address{StreetAddress: "123 North Martin Luther King Junior Boulevard Apartment 4B", City: "Saint Louis", State: "MO", PostalCode: "63101-1234"}
+
+	tests := []struct {
+		name        string
+		opts        FormatOptions
+		lines       []string
+		abbreviated bool
+		truncated   bool
+	}{
+		{
+			name:  "single line",
+			opts:  FormatOptions{Format: FormatSingleLine},
+			lines: []string{"123 North Martin Luther King Junior Boulevard Apartment 4B, Saint Louis, MO 63101-1234"},
+		},
+		{
+			name:  "multi line",
+			opts:  FormatOptions{Format: FormatMultiLine},
+			lines: []string{"123 North Martin Luther King Junior Boulevard Apartment 4B", "Saint Louis, MO 63101-1234"},
+		},
+		{
+			name:  "usps label",
+			opts:  FormatOptions{Format: FormatUSPSLabel},
+			lines: []string{"123 N MARTIN LUTHER KING JUNIOR BLVD APT 4B", "SAINT LOUIS MO 63101-1234"},
+		},
+		{
+			name:        "40 characters moves the unit above the delivery line",
+			opts:        FormatOptions{Format: FormatUSPSLabel, MaxLineLength: 40},
+			lines:       []string{"APT 4B", "123 N MARTIN LUTHER KING JUNIOR BLVD", "SAINT LOUIS MO 63101-1234"},
+			abbreviated: true,
+		},
+		{
+			name:        "35 characters abbreviates name words",
+			opts:        FormatOptions{Format: FormatMultiLine, MaxLineLength: 35},
+			lines:       []string{"APT 4B", "123 N MARTIN LUTHER KING JR BLVD", "Saint Louis, MO 63101-1234"},
+			abbreviated: true,
+		},
+		{
+			name:        "single line is cut in the street, keeping the last line",
+			opts:        FormatOptions{Format: FormatSingleLine, MaxLineLength: 40},
+			lines:       []string{"123 N MARTIN LUTHER, ST LOUIS, MO 63101"},
+			abbreviated: true,
+			truncated:   true,
+		},
+		{
+			name:        "a short limit abbreviates the city and drops the ZIP+4",
+			opts:        FormatOptions{Format: FormatUSPSLabel, MaxLineLength: 20},
+			lines:       []string{"APT 4B", "123 N MARTIN LUTHER", "ST LOUIS MO 63101"},
+			abbreviated: true,
+			truncated:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addr.Format(tt.opts)
+
+			assert.Equal(t, tt.lines, got.Lines)
+			assert.Equal(t, tt.abbreviated, got.Abbreviated)
+			assert.Equal(t, tt.truncated, got.Truncated)
+			for _, line := range got.Lines {
+				assert.True(t, fits(line, tt.opts.MaxLineLength), line)
+			}
+		})
+	}
+}
+
+func TestAddress_Format_ShortAddresses(t *testing.T) {
+	t.Run("lines that fit are left alone", func(t *testing.T) {
+		addr := &Address{StreetAddress: "123 Main St", City: "Springfield", State: "IL", PostalCode: "62701"}
+
+		got := addr.Format(FormatOptions{Format: FormatMultiLine, MaxLineLength: 35})
+		assert.Equal(t, []string{"123 Main St", "Springfield, IL 62701"}, got.Lines)
+		assert.False(t, got.Abbreviated)
+		assert.Equal(t, addr.FormatAddress(), addr.Format(FormatOptions{Format: FormatSingleLine}).Lines[0])
+	})
+
+	t.Run("a locality has only the last line", func(t *testing.T) {
+		addr := &Address{City: "Coeur d'Alene", State: "id"}
+
+		assert.Equal(t, []string{"COEUR D ALENE ID"}, addr.Format(FormatOptions{Format: FormatUSPSLabel}).Lines)
+		assert.Equal(t, []string{"Coeur d'Alene, ID"}, addr.Format(FormatOptions{Format: FormatMultiLine}).Lines)
+	})
+}
+
+func TestCutLine(t *testing.T) {
+	assert.Equal(t, "123 MAIN ST", cutLine("123 MAIN ST", 11))
+	assert.Equal(t, "123 MAIN", cutLine("123 MAIN ST", 10))
+	assert.Equal(t, "SPRING", cutLine("SPRINGFIELD", 6))
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
 )
 
 func TestAddress_Validate(t *testing.T) {
@@ -95,6 +97,73 @@ func TestAddress_Validate(t *testing.T) {
 	}
 }
 
+func TestAddress_ValidateMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    ValidationMode
+		address Address
+		errMsg  string
+	}{
+		{
+			name:    "locality with city and state",
+			mode:    ModeLocality,
+			address: Address{City: "Boise", State: "ID"},
+		},
+		{
+			name:    "locality without state",
+			mode:    ModeLocality,
+			address: Address{StreetAddress: "123 Main St", City: "Boise"},
+			errMsg:  "city and state must be present",
+		},
+		{
+			name:    "locality still checks state code",
+			mode:    ModeLocality,
+			address: Address{City: "Boise", State: "XX"},
+			errMsg:  "invalid state code: XX",
+		},
+		{
+			name:    "postal with bare ZIP",
+			mode:    ModePostal,
+			address: Address{PostalCode: "10001"},
+		},
+		{
+			name:    "postal with street and ZIP",
+			mode:    ModePostal,
+			address: Address{StreetAddress: "123 Main St", PostalCode: "10001"},
+		},
+		{
+			name:    "postal without ZIP",
+			mode:    ModePostal,
+			address: Address{City: "New York", State: "NY"},
+			errMsg:  "postal_code must be present",
+		},
+		{
+			name:    "postal checks ZIP format",
+			mode:    ModePostal,
+			address: Address{PostalCode: "1000"},
+			errMsg:  "postal_code must be 5 or 9-digit format",
+		},
+		{
+			name:    "full rejects bare ZIP",
+			mode:    ModeFull,
+			address: Address{PostalCode: "10001"},
+			errMsg:  "at least 2 of street_address, city, state must be present",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.address.ValidateMode(tt.mode)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAddress_FormatAddress(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -139,3 +208,163 @@ func TestAddress_FormatAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestAddress_ValidatePolicy(t *testing.T) {
+	complete := Address{
+		StreetAddress: "123 Main St",
+		City:          "New York",
+		State:         "NY",
+		PostalCode:    "10001",
+	}
+
+	tests := []struct {
+		name    string
+		policy  ValidationPolicy
+		address Address
+		errMsg  string
+		code    domainerrors.Code
+	}{
+		{
+			name:    "strict accepts a complete address",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStrict},
+			address: complete,
+		},
+		{
+			name:    "strict requires a ZIP",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStrict},
+			address: Address{StreetAddress: "123 Main St", City: "New York", State: "NY"},
+			errMsg:  "postal_code is required by strict validation",
+			code:    domainerrors.CodeZIPRequired,
+		},
+		{
+			name:    "strict requires a house number",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStrict},
+			address: Address{StreetAddress: "Main St", City: "New York", State: "NY", PostalCode: "10001"},
+			errMsg:  "street_address must start with a house number",
+			code:    domainerrors.CodeHouseNumberMissing,
+		},
+		{
+			name:   "strict rejects fuzzy corrections",
+			policy: ValidationPolicy{Mode: ModeLocality, Strictness: StrictnessStrict},
+			address: Address{
+				City:     "Austin",
+				State:    "TX",
+				Evidence: map[string]Evidence{ComponentState: EvidenceFuzzy},
+			},
+			errMsg: "state needed a fuzzy correction",
+			code:   domainerrors.CodeFuzzyCorrection,
+		},
+		{
+			name:   "standard allows fuzzy corrections",
+			policy: ValidationPolicy{Mode: ModeLocality, Strictness: StrictnessStandard},
+			address: Address{
+				City:     "Austin",
+				State:    "TX",
+				Evidence: map[string]Evidence{ComponentState: EvidenceFuzzy},
+			},
+		},
+		{
+			name:    "strict allows a ZIP assigned to another state",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStrict},
+			address: Address{StreetAddress: "123 Main St", City: "New York", State: "NY", PostalCode: "90210", Evidence: map[string]Evidence{ComponentPostalCode: EvidenceConflict}},
+		},
+		{
+			name:    "standard allows a ZIP assigned to another state",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStandard},
+			address: Address{StreetAddress: "123 Main St", City: "New York", State: "NY", PostalCode: "90210", Evidence: map[string]Evidence{ComponentPostalCode: EvidenceConflict}},
+		},
+		{
+			name:    "lenient accepts a single component",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessLenient},
+			address: Address{City: "Springfield"},
+		},
+		{
+			name:    "lenient ignores unknown state codes",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessLenient},
+			address: Address{City: "Faketown", State: "XX"},
+		},
+		{
+			name:    "lenient still needs something",
+			policy:  ValidationPolicy{Mode: ModeFull, Strictness: StrictnessLenient},
+			address: Address{},
+			errMsg:  "at least one address component must be present",
+			code:    domainerrors.CodeComponentsInsufficient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.address.ValidatePolicy(tt.policy)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				assert.Equal(t, tt.code, domainerrors.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAddress_ValidatePolicy_ReportsEveryProblem(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   ValidationPolicy
+		address  Address
+		expected []domainerrors.Code
+		fields   []string
+	}{
+		{
+			name:     "bad state and bad ZIP",
+			policy:   ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStandard},
+			address:  Address{StreetAddress: "123 Main St", City: "Faketown", State: "XX", PostalCode: "123"},
+			expected: []domainerrors.Code{domainerrors.CodeStateInvalid, domainerrors.CodeZIPFormat},
+			fields:   []string{ComponentState, ComponentPostalCode},
+		},
+		{
+			name:     "missing components and a bad ZIP",
+			policy:   ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStandard},
+			address:  Address{City: "Faketown", PostalCode: "ABCDE"},
+			expected: []domainerrors.Code{domainerrors.CodeComponentsInsufficient, domainerrors.CodeZIPFormat},
+			fields:   []string{"address", ComponentPostalCode},
+		},
+		{
+			name:   "every strict rule",
+			policy: ValidationPolicy{Mode: ModeFull, Strictness: StrictnessStrict},
+			address: Address{
+				StreetAddress: "Main St",
+				City:          "Austin",
+				State:         "TX",
+				Evidence:      map[string]Evidence{ComponentCity: EvidenceFuzzy, ComponentState: EvidenceFuzzy},
+			},
+			expected: []domainerrors.Code{
+				domainerrors.CodeZIPRequired,
+				domainerrors.CodeHouseNumberMissing,
+				domainerrors.CodeFuzzyCorrection,
+				domainerrors.CodeFuzzyCorrection,
+			},
+			fields: []string{ComponentPostalCode, ComponentStreetAddress, ComponentCity, ComponentState},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.address.ValidatePolicy(tt.policy)
+			require.Error(t, err)
+
+			var (
+				codes  []domainerrors.Code
+				fields []string
+			)
+			for _, problem := range domainerrors.Problems(err) {
+				codes = append(codes, domainerrors.CodeOf(problem))
+
+				var parsingErr *domainerrors.ParsingError
+				require.ErrorAs(t, problem, &parsingErr)
+				fields = append(fields, parsingErr.Field)
+			}
+			assert.Equal(t, tt.expected, codes)
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// CanonicalKeyVersion prefixes every canonical key. Bump it whenever the key
+// composition or the standardization behind it (ParseStreetLine, CanonicalCity)
+// changes, so keys built by different versions never compare equal by accident.
+// Keys are built from parser output too, so a parser version bump needs a decision
+// here; tests pin both the standardization tables and the parser version to it.
+const CanonicalKeyVersion = "v1"
+
+// cityWordAbbreviations folds the spelled-out and abbreviated forms of common city name words.
+var cityWordAbbreviations = map[string]string{
+	"SAINT": "ST", "SAINTE": "STE", "FORT": "FT", "MOUNT": "MT",
+}
+
+// CanonicalKey returns a deterministic key for the place an address describes:
+//
+//	v1|<number>|<pre-directional>|<street name>|<suffix>|<post-directional>|<unit>|<city>|<state>|<zip5>
+//
+// Every element is standardized the USPS way (see ParseStreetLine and CanonicalCity),
+// so addresses that differ only in case, punctuation or abbreviations share a key.
+// The unit designator is left out, making "Apt 4" and "#4" the same unit, and only
+// the 5-digit ZIP is used. Absent elements are empty.
+func (a *Address) CanonicalKey() string {
+	line := ParseStreetLine(a.StreetAddress)
+	zip := a.PostalCode
+	if len(zip) > 5 {
+		zip = zip[:5]
+	}
+
+	fields := []string{
+		CanonicalKeyVersion,
+		line.Number, line.PreDirectional, line.Name, line.Suffix, line.PostDirectional, line.Unit,
+		CanonicalCity(a.City), strings.ToUpper(strings.TrimSpace(a.State)), zip,
+	}
+	for i, field := range fields {
+		fields[i] = strings.ReplaceAll(field, "|", " ")
+	}
+	return strings.Join(fields, "|")
+}
+
+// CanonicalHash returns the first 128 bits of the SHA-256 of CanonicalKey, hex encoded.
+// It is stable for a given key version and short enough to index and join on.
+func (a *Address) CanonicalHash() string {
+	sum := sha256.Sum256([]byte(a.CanonicalKey()))
+	return hex.EncodeToString(sum[:16])
+}
+
+// CanonicalCity upper-cases a city, drops periods and abbreviates words such as
+// Saint and Fort, so "Saint Louis" and "St. Louis" compare equal.
+func CanonicalCity(city string) string {
+	words := strings.Fields(strings.ToUpper(strings.ReplaceAll(city, ".", "")))
+	for i, word := range words {
+		if abbr, ok := cityWordAbbreviations[word]; ok {
+			words[i] = abbr
+		}
+	}
+	return strings.Join(words, " ")
+}
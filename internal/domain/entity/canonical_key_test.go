@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress_CanonicalKey(t *testing.T) {
+	addr := &Address{StreetAddress: "123 North Main Street Apt 4", City: "Saint Louis", State: "MO", PostalCode: "63101-1234"}
+
+	assert.Equal(t, "v1|123|N|MAIN|ST||4|ST LOUIS|MO|63101", addr.CanonicalKey())
+
+	t.Run("spelling variants share a key", func(t *testing.T) {
+		variant := &Address{StreetAddress: "123 n. MAIN st #4", City: "St. Louis", State: "mo", PostalCode: "63101"}
+		assert.Equal(t, addr.CanonicalKey(), variant.CanonicalKey())
+		assert.Equal(t, addr.CanonicalHash(), variant.CanonicalHash())
+	})
+
+	t.Run("a different unit changes the key", func(t *testing.T) {
+		other := &Address{StreetAddress: "123 N Main St Apt 5", City: "St Louis", State: "MO", PostalCode: "63101"}
+		assert.NotEqual(t, addr.CanonicalKey(), other.CanonicalKey())
+	})
+
+	t.Run("absent elements are empty", func(t *testing.T) {
+		assert.Equal(t, "v1|||||||BOISE|ID|", (&Address{City: "Boise", State: "ID"}).CanonicalKey())
+	})
+}
+
+func TestAddress_CanonicalHash(t *testing.T) {
+	addr := &Address{StreetAddress: "123 Main St", City: "Springfield", State: "IL", PostalCode: "62701"}
+
+	// The hash is part of the v1 contract: a change here breaks keys stored by clients.
+	// echo -n 'v1|123||MAIN|ST|||SPRINGFIELD|IL|62701' | sha256sum | cut -c1-32
+	assert.Equal(t, "v1|123||MAIN|ST|||SPRINGFIELD|IL|62701", addr.CanonicalKey())
+	assert.Equal(t, "23fbd03253308dc1abb1109034265b05", addr.CanonicalHash())
+	assert.Len(t, addr.CanonicalHash(), 32)
+}
+
+func TestCanonicalCity(t *testing.T) {
+	assert.Equal(t, "ST LOUIS", CanonicalCity("Saint Louis"))
+	assert.Equal(t, "ST LOUIS", CanonicalCity(" st.  louis "))
+	assert.Equal(t, "FT WORTH", CanonicalCity("Fort Worth"))
+	assert.Equal(t, "SALT LAKE CITY", CanonicalCity("Salt Lake City"))
+}
+
+// canonicalRules summarizes everything that standardizes a canonical key element, so a
+// change to any table or pattern changes it.
+func canonicalRules() string {
+	var b strings.Builder
+	for _, table := range []map[string]string{
+		streetSuffixAbbreviations, directionalAbbreviations, unitDesignatorAbbreviations, cityWordAbbreviations,
+	} {
+		words := make([]string, 0, len(table))
+		for word := range table {
+			words = append(words, word)
+		}
+		sort.Strings(words)
+		for _, word := range words {
+			fmt.Fprintf(&b, "%s=%s;", word, table[word])
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(houseNumberPattern.String())
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:8])
+}
+
+func TestCanonicalKeyVersion_PinsRules(t *testing.T) {
+	// Each key version pins the standardization rules it was built with. When this fails,
+	// the rules changed: bump CanonicalKeyVersion and add the new version's fingerprint,
+	// leaving the old entries in place.
+	fingerprints := map[string]string{
+		"v1": "61809aa2074ff2c1",
+	}
+
+	assert.Equal(t, fingerprints[CanonicalKeyVersion], canonicalRules(),
+		"street line or city rules changed without a CanonicalKeyVersion bump")
+}
@@ -0,0 +1,62 @@
+package entity
+
+// CorrectionType classifies what a normalization step changed.
+type CorrectionType string
+
+const (
+	// CorrectionWhitespace means leading, trailing or repeated whitespace was removed from the input.
+	CorrectionWhitespace CorrectionType = "whitespace"
+	// CorrectionCapitalization means a component was re-cased, e.g. "main st" to "Main St".
+	CorrectionCapitalization CorrectionType = "capitalization"
+	// CorrectionStateName means a state given by name was replaced by its USPS code.
+	CorrectionStateName CorrectionType = "state_name"
+	// CorrectionDropped means a malformed component was removed instead of failing validation.
+	CorrectionDropped CorrectionType = "dropped"
+)
+
+// Correction records one change normalization made to the input. Component is empty
+// for changes to the address as a whole.
+type Correction struct {
+	Component string         `json:"component,omitempty"`
+	Original  string         `json:"original"`
+	Corrected string         `json:"corrected"`
+	Type      CorrectionType `json:"type"`
+	Reason    string         `json:"reason"`
+}
+
+// String renders the correction as the plain-text message v1 clients receive in
+// corrections_applied. Messages carry no values except for dropped components.
+func (c Correction) String() string {
+	switch c.Type {
+	case CorrectionWhitespace:
+		return "Normalized whitespace"
+	case CorrectionCapitalization:
+		return "Standardized capitalization"
+	case CorrectionStateName:
+		return "Abbreviated state name"
+	case CorrectionDropped:
+		switch c.Component {
+		case ComponentState:
+			return "Dropped unrecognized state " + c.Original
+		case ComponentPostalCode:
+			return "Dropped malformed postal code " + c.Original
+		}
+		return "Dropped " + c.Component + " " + c.Original
+	}
+	return c.Reason
+}
+
+// CorrectionMessages renders corrections for v1 clients, dropping repeated messages
+// so per-component corrections of one type read as a single entry.
+func CorrectionMessages(corrections []Correction) []string {
+	var messages []string
+	seen := make(map[string]bool, len(corrections))
+	for _, c := range corrections {
+		message := c.String()
+		if !seen[message] {
+			seen[message] = true
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrectionMessages(t *testing.T) {
+	corrections := []Correction{
+		{Original: " 123 main st", Corrected: "123 main st", Type: CorrectionWhitespace},
+		{Component: ComponentStreetAddress, Original: "123 main st", Corrected: "123 Main St", Type: CorrectionCapitalization},
+		{Component: ComponentCity, Original: "boise", Corrected: "Boise", Type: CorrectionCapitalization},
+		{Component: ComponentState, Original: "Idaho", Corrected: "ID", Type: CorrectionStateName},
+		{Component: ComponentPostalCode, Original: "8370", Type: CorrectionDropped},
+	}
+
+	assert.Equal(t, []string{
+		"Normalized whitespace",
+		"Standardized capitalization",
+		"Abbreviated state name",
+		"Dropped malformed postal code 8370",
+	}, CorrectionMessages(corrections))
+	assert.Nil(t, CorrectionMessages(nil))
+}
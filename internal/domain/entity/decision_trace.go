@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Stages of validation a Decision can belong to, in the order they run.
+const (
+	// StageParse covers how the parser split the input into components.
+	StageParse = "parse"
+	// StageReference covers checks and fills against the bundled reference data.
+	StageReference = "reference"
+	// StagePolicy covers what the mode and strictness required or dropped.
+	StagePolicy = "policy"
+	// StageConfidence covers how each score was reached.
+	StageConfidence = "confidence"
+	// StageStandardize covers the USPS abbreviations behind the canonical key and formats.
+	StageStandardize = "standardize"
+)
+
+// Decision is one step taken while validating an address: which rule fired, on which
+// component and value, and why.
+type Decision struct {
+	Stage string `json:"stage"`
+	// Rule names the rule that fired, such as zip_token or suffix_table.
+	Rule      string `json:"rule"`
+	Component string `json:"component,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Detail    string `json:"detail"`
+}
+
+// DecisionTrace records decisions in the order they are taken. A nil trace records
+// nothing, so code can record unconditionally and pay nothing unless asked to explain.
+type DecisionTrace struct {
+	mu        sync.Mutex
+	decisions []Decision
+}
+
+// Addf records a decision whose detail is formatted from format and args.
+func (t *DecisionTrace) Addf(stage, rule, component, value, format string, args ...any) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.decisions = append(t.decisions, Decision{
+		Stage:     stage,
+		Rule:      rule,
+		Component: component,
+		Value:     value,
+		Detail:    fmt.Sprintf(format, args...),
+	})
+}
+
+// Decisions returns the decisions recorded so far, oldest first.
+func (t *DecisionTrace) Decisions() []Decision {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Decision(nil), t.decisions...)
+}
+
+type decisionTraceKey struct{}
+
+// ContextWithDecisionTrace returns a context that carries trace for parsers to record into.
+func ContextWithDecisionTrace(ctx context.Context, trace *DecisionTrace) context.Context {
+	return context.WithValue(ctx, decisionTraceKey{}, trace)
+}
+
+// DecisionTraceFromContext returns the trace carried by ctx, or nil if explaining was not requested.
+func DecisionTraceFromContext(ctx context.Context) *DecisionTrace {
+	trace, _ := ctx.Value(decisionTraceKey{}).(*DecisionTrace)
+	return trace
+}
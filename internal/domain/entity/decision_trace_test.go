@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecisionTrace(t *testing.T) {
+	t.Run("records decisions in order", func(t *testing.T) {
+		trace := &DecisionTrace{}
+		trace.Addf(StageParse, "zip_token", ComponentPostalCode, "10001", "Took %s as the ZIP code", "10001")
+		trace.Addf(StageConfidence, "ambiguity_penalty", "", "", "Lowered the overall score")
+
+		assert.Equal(t, []Decision{
+			{Stage: StageParse, Rule: "zip_token", Component: ComponentPostalCode, Value: "10001", Detail: "Took 10001 as the ZIP code"},
+			{Stage: StageConfidence, Rule: "ambiguity_penalty", Detail: "Lowered the overall score"},
+		}, trace.Decisions())
+	})
+
+	t.Run("nil trace records nothing", func(t *testing.T) {
+		var trace *DecisionTrace
+		trace.Addf(StageParse, "zip_token", "", "", "ignored")
+
+		assert.Nil(t, trace.Decisions())
+	})
+
+	t.Run("carried by context", func(t *testing.T) {
+		trace := &DecisionTrace{}
+
+		assert.Same(t, trace, DecisionTraceFromContext(ContextWithDecisionTrace(context.Background(), trace)))
+		assert.Nil(t, DecisionTraceFromContext(context.Background()))
+	})
+}
@@ -0,0 +1,58 @@
+package entity
+
+// Evidence describes how a parser arrived at a component value.
+type Evidence string
+
+const (
+	// EvidenceReference means the value was taken from the input and agrees with reference data.
+	EvidenceReference Evidence = "reference"
+	// EvidenceExact means the value was taken verbatim from a clearly delimited token.
+	EvidenceExact Evidence = "exact"
+	// EvidenceFuzzy means the value was repaired from a near match (e.g. a full state name).
+	EvidenceFuzzy Evidence = "fuzzy"
+	// EvidenceSegmented means the value was assigned by position because the input had no delimiters.
+	EvidenceSegmented Evidence = "segmented"
+	// EvidenceInferred means the value was absent from the input and filled in from reference data.
+	EvidenceInferred Evidence = "inferred"
+	// EvidenceConflict means the value was found but disagrees with reference data.
+	EvidenceConflict Evidence = "conflict"
+)
+
+var evidenceScores = map[Evidence]float64{
+	EvidenceReference: 0.98,
+	EvidenceExact:     0.9,
+	EvidenceFuzzy:     0.75,
+	EvidenceInferred:  0.7,
+	EvidenceSegmented: 0.6,
+	EvidenceConflict:  0.3,
+}
+
+// Score returns the confidence in [0, 1] that a component backed by this evidence is correct.
+func (e Evidence) Score() float64 {
+	return evidenceScores[e]
+}
+
+// componentWeights sets how much each component contributes to the overall score.
+var componentWeights = map[string]float64{
+	ComponentStreetAddress: 0.3,
+	ComponentCity:          0.25,
+	ComponentState:         0.25,
+	ComponentPostalCode:    0.2,
+}
+
+// OverallScore combines per-component scores into a weighted mean over the components present.
+func OverallScore(scores map[string]float64) float64 {
+	total, weight := 0.0, 0.0
+	for name, score := range scores {
+		if score <= 0 {
+			continue
+		}
+		total += score * componentWeights[name]
+		weight += componentWeights[name]
+	}
+
+	if weight == 0 {
+		return 0
+	}
+	return total / weight
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidence_Score(t *testing.T) {
+	assert.Greater(t, EvidenceReference.Score(), EvidenceExact.Score())
+	assert.Greater(t, EvidenceExact.Score(), EvidenceFuzzy.Score())
+	assert.Greater(t, EvidenceFuzzy.Score(), EvidenceInferred.Score())
+	assert.Greater(t, EvidenceInferred.Score(), EvidenceSegmented.Score())
+	assert.Greater(t, EvidenceSegmented.Score(), EvidenceConflict.Score())
+	assert.Zero(t, Evidence("unknown").Score())
+}
+
+func TestOverallScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		scores   map[string]float64
+		expected float64
+	}{
+		{
+			name:     "no components",
+			scores:   map[string]float64{},
+			expected: 0,
+		},
+		{
+			name: "uniform scores",
+			scores: map[string]float64{
+				ComponentStreetAddress: 0.9,
+				ComponentCity:          0.9,
+				ComponentState:         0.9,
+				ComponentPostalCode:    0.9,
+			},
+			expected: 0.9,
+		},
+		{
+			name: "absent components do not drag the mean down",
+			scores: map[string]float64{
+				ComponentCity:       0.6,
+				ComponentState:      1.0,
+				ComponentPostalCode: 0,
+			},
+			expected: 0.8,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, OverallScore(tt.scores), 0.0001)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package entity
+
+import "time"
+
+// JobStatus is the lifecycle state of a batch validation job.
+type JobStatus string
+
+// Job statuses. Jobs move from queued to running, then to done or failed.
+const (
+	JobQueued  JobStatus = "queued"
+	JobRunning JobStatus = "running"
+	JobDone    JobStatus = "done"
+	JobFailed  JobStatus = "failed"
+)
+
+// JobItemStatus is the state of one address within a job.
+type JobItemStatus string
+
+// Job item statuses. An item fails when its address cannot be validated.
+const (
+	JobItemPending   JobItemStatus = "pending"
+	JobItemSucceeded JobItemStatus = "succeeded"
+	JobItemFailed    JobItemStatus = "failed"
+)
+
+// Job is a batch of addresses validated in the background.
+type Job struct {
+	ID     string
+	Tenant string
+	Status JobStatus
+	Error  string
+	// Callback is nil when the submitter did not ask for webhooks.
+	Callback *JobCallback
+	Progress JobProgress
+	// Attempts counts the runs that stopped without finishing, such as on a storage
+	// outage; the job is failed once it reaches the runner's limit.
+	Attempts  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// JobProgress counts a job's items by outcome.
+type JobProgress struct {
+	Total     int
+	Processed int
+	Succeeded int
+	Failed    int
+}
+
+// JobItem is one address submitted in a job, identified by its position in the submission.
+type JobItem struct {
+	Index   int
+	Address string
+	Status  JobItemStatus
+}
+
+// JobCallback is where and for which events a job's webhooks are delivered.
+type JobCallback struct {
+	URL    string
+	Events []WebhookEvent
+}
+
+// Wants reports whether the callback subscribed to event.
+func (c *JobCallback) Wants(event WebhookEvent) bool {
+	if c == nil {
+		return false
+	}
+	for _, e := range c.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
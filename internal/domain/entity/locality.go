@@ -0,0 +1,23 @@
+package entity
+
+// Locality is the city and state a ZIP code is assigned to.
+type Locality struct {
+	PostalCode string `json:"postal_code"`
+	City       string `json:"city,omitempty"`
+	State      string `json:"state"`
+}
+
+// Reference data coverage, reported with results drawn from reference data so callers
+// can tell a miss in a sample from an address that does not exist.
+const (
+	// CoverageSample is a small sample of US addresses, for development and tests.
+	CoverageSample = "sample"
+	// CoverageFull is a dataset meant to cover every US address.
+	CoverageFull = "full"
+)
+
+// Street is a named street within a ZIP code's locality.
+type Street struct {
+	Name     string
+	Locality Locality
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "context"
+
+// Cache statuses recorded in ParseMetadata.
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// ParseMetadata collects facts about how a parse was served, so they can be
+// reported back to the caller without widening the repository interface.
+type ParseMetadata struct {
+	Cache string `json:"cache,omitempty"`
+}
+
+type parseMetadataKey struct{}
+
+// ContextWithParseMetadata returns a context that carries meta for repositories to fill in.
+func ContextWithParseMetadata(ctx context.Context, meta *ParseMetadata) context.Context {
+	return context.WithValue(ctx, parseMetadataKey{}, meta)
+}
+
+// ParseMetadataFromContext returns the metadata carried by ctx, or nil if there is none.
+func ParseMetadataFromContext(ctx context.Context) *ParseMetadata {
+	meta, _ := ctx.Value(parseMetadataKey{}).(*ParseMetadata)
+	return meta
+}
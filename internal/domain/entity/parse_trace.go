@@ -0,0 +1,21 @@
+package entity
+
+// ParseLabel is one component as the parser labeled it, before any normalization.
+type ParseLabel struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// ParseTrace shows how the active parser read an address: the labels it produced and
+// the components they normalize to. Comparing the two tells a parser mistake from a
+// normalization one.
+type ParseTrace struct {
+	// Parser is the ParserVersion of the parser that produced the trace.
+	Parser string `json:"parser"`
+	// Labels are in the order the parser produced them. Label names are the parser's
+	// own, such as libpostal's house_number and road.
+	Labels []ParseLabel `json:"labels"`
+	// Normalized maps each component name (ComponentStreetAddress, ...) to its value
+	// after normalization; components the parser did not find are left out.
+	Normalized map[string]string `json:"normalized"`
+}
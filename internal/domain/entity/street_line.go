@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// StreetLine is a street address split into its USPS Publication 28 elements.
+// Every element is upper case and abbreviated the USPS way, so two spellings of
+// the same street ("123 north Main Street" and "123 N. MAIN ST") parse equal.
+type StreetLine struct {
+	Number          string
+	PreDirectional  string
+	Name            string
+	Suffix          string
+	PostDirectional string
+	// UnitType is the secondary unit designator, such as APT or STE; "#" when only a number sign was given.
+	UnitType string
+	Unit     string
+}
+
+// The USPS Publication 28 tables the service knows, from each standard abbreviation
+// to the other ways it is written, the spelled-out name first. They are the one source
+// of street words: the lookup tables below and the parser and autocomplete word lists
+// are all derived from them.
+var (
+	uspsStreetSuffixes = map[string][]string{
+		"ALY": {"ALLEY"}, "AVE": {"AVENUE", "AV"}, "BLVD": {"BOULEVARD"}, "CTR": {"CENTER"},
+		"CIR": {"CIRCLE"}, "CT": {"COURT"}, "XING": {"CROSSING"}, "DR": {"DRIVE"},
+		"EXPY": {"EXPRESSWAY"}, "FWY": {"FREEWAY"}, "HTS": {"HEIGHTS"}, "HWY": {"HIGHWAY"},
+		"LN": {"LANE"}, "LOOP": nil, "MALL": nil, "PKWY": {"PARKWAY"}, "PIKE": nil,
+		"PL": {"PLACE"}, "PLZ": {"PLAZA"}, "PT": {"POINT"}, "RD": {"ROAD"}, "ROW": nil, "RUN": nil,
+		"SQ": {"SQUARE"}, "ST": {"STREET", "STR"}, "TER": {"TERRACE"}, "TRL": {"TRAIL"},
+		"WALK": nil, "WAY": nil,
+	}
+
+	uspsDirectionals = map[string][]string{
+		"N": {"NORTH"}, "S": {"SOUTH"}, "E": {"EAST"}, "W": {"WEST"},
+		"NE": {"NORTHEAST"}, "NW": {"NORTHWEST"}, "SE": {"SOUTHEAST"}, "SW": {"SOUTHWEST"},
+	}
+
+	uspsUnitDesignators = map[string][]string{
+		"APT": {"APARTMENT"}, "BLDG": {"BUILDING"}, "DEPT": {"DEPARTMENT"}, "FL": {"FLOOR"},
+		"LOT": nil, "RM": {"ROOM"}, "SPC": {"SPACE"}, "STE": {"SUITE"}, "TRLR": {"TRAILER"},
+		"UNIT": nil, "#": nil,
+	}
+)
+
+// houseNumberPattern matches a house number: digits, optionally a hyphenated second
+// part as in Queens, and at most one letter ("123", "12-34", "4B"). Ordinals such as
+// "1ST" do not match, so "1st Ave" has no house number.
+var houseNumberPattern = regexp.MustCompile(`^\d+(-\d+)?[A-Z]?$`)
+
+// Lookup tables from every way a word is written to its USPS abbreviation.
+var (
+	streetSuffixAbbreviations   = abbreviationsOf(uspsStreetSuffixes)
+	directionalAbbreviations    = abbreviationsOf(uspsDirectionals)
+	unitDesignatorAbbreviations = abbreviationsOf(uspsUnitDesignators)
+)
+
+func abbreviationsOf(table map[string][]string) map[string]string {
+	out := make(map[string]string)
+	for abbr, spellings := range table {
+		out[abbr] = abbr
+		for _, spelling := range spellings {
+			out[spelling] = abbr
+		}
+	}
+	return out
+}
+
+// StreetSuffixes returns every spelling of a USPS street suffix, abbreviated or not, in
+// upper case and sorted.
+func StreetSuffixes() []string {
+	out := make([]string, 0, len(streetSuffixAbbreviations))
+	for word := range streetSuffixAbbreviations {
+		out = append(out, word)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// IsStreetSuffix reports whether word, in any case, is a USPS street suffix.
+func IsStreetSuffix(word string) bool {
+	_, ok := streetSuffixAbbreviations[strings.ToUpper(word)]
+	return ok
+}
+
+// IsDirectional reports whether word, in any case, is a compass direction.
+func IsDirectional(word string) bool {
+	_, ok := directionalAbbreviations[strings.ToUpper(word)]
+	return ok
+}
+
+// SpellOutStreetWord returns the spelled-out name, in upper case, of a street suffix or
+// directional abbreviation given in any case ("st" gives "STREET"). It reports false for
+// other words and for suffixes that have no longer form, such as WAY.
+func SpellOutStreetWord(word string) (string, bool) {
+	word = strings.ToUpper(word)
+	for _, table := range []map[string][]string{uspsStreetSuffixes, uspsDirectionals} {
+		if spellings := table[word]; len(spellings) > 0 {
+			return spellings[0], true
+		}
+	}
+	return "", false
+}
+
+// ParseStreetLine splits a street address such as "123 N Main St Apt 4" into its
+// elements. Words it cannot place become part of the name.
+func ParseStreetLine(line string) StreetLine {
+	return TraceStreetLine(line, nil)
+}
+
+// TraceStreetLine is ParseStreetLine, recording in trace which abbreviation table
+// entry placed each element.
+func TraceStreetLine(line string, trace *DecisionTrace) StreetLine {
+	var tokens []string
+	for _, word := range strings.Fields(strings.ToUpper(line)) {
+		word = strings.Trim(word, ".,;:")
+		if rest, ok := strings.CutPrefix(word, "#"); ok {
+			tokens = append(tokens, "#")
+			word = rest
+		}
+		if word != "" {
+			tokens = append(tokens, word)
+		}
+	}
+
+	var s StreetLine
+	if len(tokens) > 0 && houseNumberPattern.MatchString(tokens[0]) {
+		s.Number, tokens = tokens[0], tokens[1:]
+		trace.Addf(StageStandardize, "house_number", ComponentStreetAddress, s.Number,
+			"Took the leading %s as the house number because it is numeric", s.Number)
+	}
+
+	// The unit starts at the first designator after the street name.
+	for i := 1; i < len(tokens); i++ {
+		if unitType, ok := unitDesignatorAbbreviations[tokens[i]]; ok {
+			unit := tokens[i+1:]
+			if len(unit) > 0 && unit[0] == "#" {
+				unit = unit[1:]
+			}
+			s.UnitType, s.Unit = unitType, strings.Join(unit, " ")
+			trace.Addf(StageStandardize, "unit_table", ComponentStreetAddress, tokens[i],
+				"Took %s as the unit designator (the USPS unit table maps %s to %s); the unit is %q", tokens[i], tokens[i], unitType, s.Unit)
+			tokens = tokens[:i]
+			break
+		}
+	}
+
+	if n := len(tokens); n > 1 {
+		if dir, ok := directionalAbbreviations[tokens[n-1]]; ok {
+			trace.Addf(StageStandardize, "directional_table", ComponentStreetAddress, tokens[n-1],
+				"Took the trailing %s as the post-directional (the USPS directional table maps %s to %s)", tokens[n-1], tokens[n-1], dir)
+			s.PostDirectional, tokens = dir, tokens[:n-1]
+		}
+	}
+	if n := len(tokens); n > 1 {
+		if suffix, ok := streetSuffixAbbreviations[tokens[n-1]]; ok {
+			trace.Addf(StageStandardize, "suffix_table", ComponentStreetAddress, tokens[n-1],
+				"Took the last street word %s as the suffix (the USPS suffix table maps %s to %s)", tokens[n-1], tokens[n-1], suffix)
+			s.Suffix, tokens = suffix, tokens[:n-1]
+		}
+	}
+	if len(tokens) > 1 {
+		if dir, ok := directionalAbbreviations[tokens[0]]; ok {
+			trace.Addf(StageStandardize, "directional_table", ComponentStreetAddress, tokens[0],
+				"Took the leading %s as the pre-directional (the USPS directional table maps %s to %s)", tokens[0], tokens[0], dir)
+			s.PreDirectional, tokens = dir, tokens[1:]
+		}
+	}
+	s.Name = strings.Join(tokens, " ")
+	if s.Name != "" {
+		trace.Addf(StageStandardize, "street_name", ComponentStreetAddress, s.Name,
+			"Kept the remaining words %s as the street name", s.Name)
+	}
+
+	return s
+}
+
+// Street returns the street without the house number or unit, e.g. "N MAIN ST".
+func (s StreetLine) Street() string {
+	return joinNonEmpty(s.PreDirectional, s.Name, s.Suffix, s.PostDirectional)
+}
+
+// String returns the whole line in USPS form, e.g. "123 N MAIN ST APT 4".
+func (s StreetLine) String() string {
+	return joinNonEmpty(s.Number, s.Street(), s.secondary())
+}
+
+// secondary returns the unit with its designator, e.g. "APT 4".
+func (s StreetLine) secondary() string {
+	if s.UnitType == "" {
+		return s.Unit
+	}
+	return joinNonEmpty(s.UnitType, s.Unit)
+}
+
+func joinNonEmpty(parts ...string) string {
+	var out []string
+	for _, part := range parts {
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return strings.Join(out, " ")
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStreetLine(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected StreetLine
+	}{
+		{"123 Main St", StreetLine{Number: "123", Name: "MAIN", Suffix: "ST"}},
+		{"123 north Main Street", StreetLine{Number: "123", PreDirectional: "N", Name: "MAIN", Suffix: "ST"}},
+		{"1600 Pennsylvania Avenue Northwest", StreetLine{Number: "1600", Name: "PENNSYLVANIA", Suffix: "AVE", PostDirectional: "NW"}},
+		{"456 Oak Ave., Apartment 4B", StreetLine{Number: "456", Name: "OAK", Suffix: "AVE", UnitType: "APT", Unit: "4B"}},
+		{"456 Oak Ave Apt #4B", StreetLine{Number: "456", Name: "OAK", Suffix: "AVE", UnitType: "APT", Unit: "4B"}},
+		{"456 Oak Ave #4B", StreetLine{Number: "456", Name: "OAK", Suffix: "AVE", UnitType: "#", Unit: "4B"}},
+		{"1 Infinite Loop Suite 200", StreetLine{Number: "1", Name: "INFINITE", Suffix: "LOOP", UnitType: "STE", Unit: "200"}},
+		{"350 5th Ave", StreetLine{Number: "350", Name: "5TH", Suffix: "AVE"}},
+		{"200 Broadway", StreetLine{Number: "200", Name: "BROADWAY"}},
+		{"12 West Street", StreetLine{Number: "12", Name: "WEST", Suffix: "ST"}},
+		{"12 North", StreetLine{Number: "12", Name: "NORTH"}},
+		{"Main Street", StreetLine{Name: "MAIN", Suffix: "ST"}},
+		{"1st Ave", StreetLine{Name: "1ST", Suffix: "AVE"}},
+		{"12-34 31st Ave", StreetLine{Number: "12-34", Name: "31ST", Suffix: "AVE"}},
+		{"", StreetLine{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseStreetLine(tt.line))
+		})
+	}
+}
+
+func TestStreetLine_String(t *testing.T) {
+	line := ParseStreetLine("123 north Main Street, apartment 4")
+
+	assert.Equal(t, "N MAIN ST", line.Street())
+	assert.Equal(t, "123 N MAIN ST APT 4", line.String())
+	assert.Equal(t, line, ParseStreetLine(line.String()), "the USPS form parses back to itself")
+}
+
+func TestTraceStreetLine(t *testing.T) {
+	trace := &DecisionTrace{}
+
+	s := TraceStreetLine("123 north Main Street Apt 4", trace)
+
+	assert.Equal(t, "123 N MAIN ST APT 4", s.String())
+	var rules []string
+	for _, d := range trace.Decisions() {
+		assert.Equal(t, StageStandardize, d.Stage)
+		rules = append(rules, d.Rule)
+	}
+	assert.Equal(t, []string{"house_number", "unit_table", "suffix_table", "directional_table", "street_name"}, rules)
+	assert.Contains(t, trace.Decisions()[2].Detail, "maps STREET to ST")
+}
+
+func TestStreetWordTables(t *testing.T) {
+	assert.True(t, IsStreetSuffix("street"))
+	assert.True(t, IsStreetSuffix("Sq"))
+	assert.False(t, IsStreetSuffix("main"))
+	assert.True(t, IsDirectional("nw"))
+	assert.False(t, IsDirectional("apt"))
+	assert.Contains(t, StreetSuffixes(), "AVENUE")
+	assert.Contains(t, StreetSuffixes(), "AVE")
+
+	tests := []struct {
+		word     string
+		expected string
+		ok       bool
+	}{
+		{word: "st", expected: "STREET", ok: true},
+		{word: "SW", expected: "SOUTHWEST", ok: true},
+		{word: "way"},
+		{word: "main"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			full, ok := SpellOutStreetWord(tt.word)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, full)
+		})
+	}
+}
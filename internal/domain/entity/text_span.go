@@ -0,0 +1,9 @@
+package entity
+
+// TextSpan locates a candidate address inside a larger block of text.
+// Start and End are character (rune) offsets into the original text; End is exclusive.
+type TextSpan struct {
+	Text  string `json:"text"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"fmt"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// Warning is a non-fatal issue with an address that passed validation, such as a
+// component the caller did not write as returned.
+type Warning struct {
+	Code      domainerrors.Code
+	Component string
+	Message   string
+}
+
+// Warnings lists the components that were corrected, dropped or inferred, and a ZIP
+// code that disagrees with the state. Changes to the address as a whole, such as
+// whitespace, are left to Corrections.
+func (a *Address) Warnings() []Warning {
+	var warnings []Warning
+	for _, c := range a.Corrections {
+		switch {
+		case c.Component == "":
+			continue
+		case c.Type == CorrectionDropped:
+			warnings = append(warnings, Warning{
+				Code:      domainerrors.CodeComponentDropped,
+				Component: c.Component,
+				Message:   fmt.Sprintf("%s %s was dropped: %s", c.Component, c.Original, c.Reason),
+			})
+		default:
+			warnings = append(warnings, Warning{
+				Code:      domainerrors.CodeComponentCorrected,
+				Component: c.Component,
+				Message:   fmt.Sprintf("%s was changed from %s to %s", c.Component, c.Original, c.Corrected),
+			})
+		}
+	}
+
+	for _, name := range []string{ComponentStreetAddress, ComponentCity, ComponentState, ComponentPostalCode} {
+		if a.Evidence[name] == EvidenceInferred {
+			warnings = append(warnings, Warning{
+				Code:      domainerrors.CodeComponentInferred,
+				Component: name,
+				Message:   name + " was not given and was filled in from reference data",
+			})
+		}
+	}
+
+	if a.Evidence[ComponentPostalCode] == EvidenceConflict {
+		warnings = append(warnings, Warning{
+			Code:      domainerrors.CodeZIPStateMismatch,
+			Component: ComponentPostalCode,
+			Message:   fmt.Sprintf("postal_code %s is not assigned to state %s", a.PostalCode, a.State),
+		})
+	}
+	return warnings
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+func TestAddress_Warnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  Address
+		expected []Warning
+	}{
+		{
+			name:    "clean address",
+			address: Address{StreetAddress: "123 Main St", City: "Boise", State: "ID", PostalCode: "83702"},
+		},
+		{
+			name: "whitespace is not a component warning",
+			address: Address{Corrections: []Correction{
+				{Original: "123  Main St", Corrected: "123 Main St", Type: CorrectionWhitespace},
+			}},
+		},
+		{
+			name: "corrected and dropped components",
+			address: Address{Corrections: []Correction{
+				{Component: ComponentState, Original: "Illinois", Corrected: "IL", Type: CorrectionStateName},
+				{Component: ComponentPostalCode, Original: "1234", Type: CorrectionDropped, Reason: "Not a 5-digit or ZIP+4 code"},
+			}},
+			expected: []Warning{
+				{Code: domainerrors.CodeComponentCorrected, Component: ComponentState, Message: "state was changed from Illinois to IL"},
+				{Code: domainerrors.CodeComponentDropped, Component: ComponentPostalCode, Message: "postal_code 1234 was dropped: Not a 5-digit or ZIP+4 code"},
+			},
+		},
+		{
+			name: "inferred locality",
+			address: Address{
+				City:       "Boise",
+				State:      "ID",
+				PostalCode: "83702",
+				Evidence:   map[string]Evidence{ComponentCity: EvidenceInferred, ComponentState: EvidenceInferred},
+			},
+			expected: []Warning{
+				{Code: domainerrors.CodeComponentInferred, Component: ComponentCity, Message: "city was not given and was filled in from reference data"},
+				{Code: domainerrors.CodeComponentInferred, Component: ComponentState, Message: "state was not given and was filled in from reference data"},
+			},
+		},
+		{
+			name: "ZIP assigned to another state",
+			address: Address{
+				State:      "NY",
+				PostalCode: "90210",
+				Evidence:   map[string]Evidence{ComponentPostalCode: EvidenceConflict},
+			},
+			expected: []Warning{
+				{Code: domainerrors.CodeZIPStateMismatch, Component: ComponentPostalCode, Message: "postal_code 90210 is not assigned to state NY"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.address.Warnings())
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"context"
+	"net/netip"
+	"time"
+)
+
+// WebhookEvent names a notification a job can send.
+type WebhookEvent string
+
+// Webhook events. Jobs send job.completed by default; item.completed is opt-in.
+const (
+	EventJobCompleted  WebhookEvent = "job.completed"
+	EventItemCompleted WebhookEvent = "item.completed"
+)
+
+// IsValid reports whether e is a known event.
+func (e WebhookEvent) IsValid() bool {
+	return e == EventJobCompleted || e == EventItemCompleted
+}
+
+// nonPublicPrefixes are ranges netip does not classify that never reach a public host.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+}
+
+// IsPublicCallbackAddr reports whether a webhook may be sent to addr. Loopback, private,
+// link-local (which holds cloud metadata endpoints), multicast and other non-public
+// addresses are refused, so a callback cannot reach into the service's own network.
+func IsPublicCallbackAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeliveryStatus is the state of one webhook delivery.
+type DeliveryStatus string
+
+// Delivery statuses. A delivery that exhausts its attempts is dead, which is
+// its dead-letter record until someone redelivers it.
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryDead      DeliveryStatus = "dead"
+)
+
+// WebhookDelivery is one notification and the history of attempts to deliver it.
+// Version counts the changes stored to it, so a change based on an outdated copy
+// can be detected.
+type WebhookDelivery struct {
+	ID            string
+	JobID         string
+	Tenant        string
+	URL           string
+	Event         WebhookEvent
+	Payload       []byte
+	Status        DeliveryStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Version       int
+}
+
+// DefaultTenant owns requests that do not name a tenant.
+const DefaultTenant = "default"
+
+type tenantKey struct{}
+
+// ContextWithTenant returns a context carrying the tenant a request acts for.
+func ContextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant carried by ctx, or DefaultTenant.
+func TenantFromContext(ctx context.Context) string {
+	if tenant, ok := ctx.Value(tenantKey{}).(string); ok && tenant != "" {
+		return tenant
+	}
+	return DefaultTenant
+}
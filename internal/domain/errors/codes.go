@@ -0,0 +1,107 @@
+package errors
+
+import (
+	"errors"
+	"strings"
+)
+
+// Code identifies an error condition. Codes are stable: clients match on them instead
+// of the Reason text, so a code is never renamed or reused for another condition.
+type Code string
+
+// Address codes report why an address failed validation.
+const (
+	// CodeAddressEmpty means no address text was given.
+	CodeAddressEmpty Code = "ADDRESS_EMPTY"
+	// CodeComponentsInsufficient means the address lacks the components the mode requires.
+	CodeComponentsInsufficient Code = "COMPONENTS_INSUFFICIENT"
+	// CodeStateInvalid means the state is not a USPS state code.
+	CodeStateInvalid Code = "STATE_INVALID"
+	// CodeZIPFormat means the ZIP code is neither 5 digits nor ZIP+4.
+	CodeZIPFormat Code = "ZIP_FORMAT"
+	// CodeZIPRequired means strict validation needs a ZIP code the address lacks.
+	CodeZIPRequired Code = "ZIP_REQUIRED"
+	// CodeHouseNumberMissing means strict validation needs a street starting with a house number.
+	CodeHouseNumberMissing Code = "HOUSE_NUMBER_MISSING"
+	// CodeFuzzyCorrection means strict validation rejected a component that needed a fuzzy fix.
+	CodeFuzzyCorrection Code = "FUZZY_CORRECTION"
+	// CodeAddressUnparseable means no address components could be recognized at all.
+	CodeAddressUnparseable Code = "ADDRESS_UNPARSEABLE"
+)
+
+// Request codes report a problem with the request itself rather than the address.
+const (
+	// CodeRequestMalformed means the body, a stream line or an upload could not be decoded.
+	CodeRequestMalformed Code = "REQUEST_MALFORMED"
+	// CodeFieldRequired means a required field is missing or empty.
+	CodeFieldRequired Code = "FIELD_REQUIRED"
+	// CodeFieldInvalid means a field has a value outside its allowed set or format.
+	CodeFieldInvalid Code = "FIELD_INVALID"
+	// CodeFieldOutOfRange means a numeric field is outside its allowed range.
+	CodeFieldOutOfRange Code = "FIELD_OUT_OF_RANGE"
+	// CodeLimitExceeded means the request is larger than the service accepts.
+	CodeLimitExceeded Code = "LIMIT_EXCEEDED"
+	// CodeWebhooksDisabled means webhooks were requested for a tenant without a webhook secret.
+	CodeWebhooksDisabled Code = "WEBHOOKS_DISABLED"
+)
+
+// Warning codes report non-fatal issues with an address that passed validation.
+const (
+	// CodeComponentInferred means a missing component was filled in from reference data.
+	CodeComponentInferred Code = "COMPONENT_INFERRED"
+	// CodeComponentCorrected means normalization changed a component as written.
+	CodeComponentCorrected Code = "COMPONENT_CORRECTED"
+	// CodeComponentDropped means lenient validation removed a malformed component.
+	CodeComponentDropped Code = "COMPONENT_DROPPED"
+	// CodeAddressAmbiguous means the address has more than one valid interpretation.
+	CodeAddressAmbiguous Code = "ADDRESS_AMBIGUOUS"
+	// CodeZIPStateMismatch means the ZIP code is assigned to a different state than the one given.
+	CodeZIPStateMismatch Code = "ZIP_STATE_MISMATCH"
+)
+
+// Codes for failures that are not the caller's fault.
+const (
+	// CodeTimeout means validation did not finish within its deadline.
+	CodeTimeout Code = "TIMEOUT"
+	// CodeNotFound means the requested resource does not exist.
+	CodeNotFound Code = "NOT_FOUND"
+	// CodeInternal means an unexpected failure.
+	CodeInternal Code = "INTERNAL_ERROR"
+)
+
+// DocsBaseURL is the error catalog page; each code has its own section on it.
+const DocsBaseURL = "https://github.com/williandandrade/address-validation-service/blob/main/docs/005-error-codes.md"
+
+// DocsURL links to the catalog section that explains the code and how to fix it.
+func (c Code) DocsURL() string {
+	return DocsBaseURL + "#" + strings.ToLower(string(c))
+}
+
+// CodeOf returns the code err carries. Domain errors created without a code, such as
+// job results stored before codes existed, get the most general code of their kind;
+// errors outside the catalog are CodeInternal.
+func CodeOf(err error) Code {
+	var (
+		validationErr *ValidationError
+		parsingErr    *ParsingError
+		timeoutErr    *TimeoutError
+		notFoundErr   *NotFoundError
+	)
+	switch {
+	case errors.As(err, &validationErr):
+		if validationErr.Code == "" {
+			return CodeFieldInvalid
+		}
+		return validationErr.Code
+	case errors.As(err, &parsingErr):
+		if parsingErr.Code == "" {
+			return CodeAddressUnparseable
+		}
+		return parsingErr.Code
+	case errors.As(err, &timeoutErr):
+		return CodeTimeout
+	case errors.As(err, &notFoundErr):
+		return CodeNotFound
+	}
+	return CodeInternal
+}
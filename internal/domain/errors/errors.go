@@ -4,6 +4,8 @@ import "fmt"
 
 // ValidationError represents a request validation failure (400 Bad Request).
 type ValidationError struct {
+	// Code is the catalog entry clients match on; Reason is for people and may change.
+	Code       Code
 	Field      string
 	Reason     string
 	Value      interface{}
@@ -16,6 +18,8 @@ func (e *ValidationError) Error() string {
 
 // ParsingError represents an address parsing failure (422 Unprocessable Entity).
 type ParsingError struct {
+	// Code is the catalog entry clients match on; Reason is for people and may change.
+	Code       Code
 	Field      string
 	Reason     string
 	Value      string
@@ -37,3 +41,35 @@ type AmbiguousAddressError struct {
 func (e *AmbiguousAddressError) Error() string {
 	return e.Message
 }
+
+// TimeoutError represents a validation that did not finish within its deadline (504 Gateway Timeout).
+type TimeoutError struct {
+	Reason string
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timeout: %s", e.Reason)
+}
+
+// NotFoundError represents a lookup for a resource that does not exist (404 Not Found).
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s '%s' not found", e.Resource, e.ID)
+}
+
+// Problems splits an error made with errors.Join into the errors it joins, so a check
+// that reports several problems at once can be rendered one entry per problem. Any
+// other error is its own only problem; a nil error has none.
+func Problems(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
@@ -1,6 +1,8 @@
 package errors
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,3 +39,64 @@ func TestAmbiguousAddressError_Error(t *testing.T) {
 	}
 	assert.Equal(t, "Multiple valid interpretations found", err.Error())
 }
+
+func TestTimeoutError_Error(t *testing.T) {
+	err := &TimeoutError{Reason: "no result after 5s"}
+	assert.Equal(t, "timeout: no result after 5s", err.Error())
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Resource: "job", ID: "abc"}
+	assert.Equal(t, "job 'abc' not found", err.Error())
+}
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected Code
+	}{
+		{"validation error", &ValidationError{Code: CodeFieldOutOfRange}, CodeFieldOutOfRange},
+		{"parsing error", &ParsingError{Code: CodeStateInvalid}, CodeStateInvalid},
+		{"wrapped", fmt.Errorf("validate: %w", &ParsingError{Code: CodeZIPFormat}), CodeZIPFormat},
+		{"validation error without a code", &ValidationError{}, CodeFieldInvalid},
+		{"parsing error without a code", &ParsingError{}, CodeAddressUnparseable},
+		{"timeout", &TimeoutError{}, CodeTimeout},
+		{"not found", &NotFoundError{}, CodeNotFound},
+		{"outside the catalog", errors.New("boom"), CodeInternal},
+		{"joined", errors.Join(&ParsingError{Code: CodeStateInvalid}, &ParsingError{Code: CodeZIPFormat}), CodeStateInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CodeOf(tt.err))
+		})
+	}
+}
+
+func TestCode_DocsURL(t *testing.T) {
+	assert.Equal(t, DocsBaseURL+"#zip_state_mismatch", CodeZIPStateMismatch.DocsURL())
+}
+
+func TestProblems(t *testing.T) {
+	state := &ParsingError{Code: CodeStateInvalid}
+	zip := &ParsingError{Code: CodeZIPFormat}
+	wrapped := fmt.Errorf("validate: %w", errors.Join(state, zip))
+
+	tests := []struct {
+		name     string
+		err      error
+		expected []error
+	}{
+		{"nil", nil, nil},
+		{"single", state, []error{state}},
+		{"joined", errors.Join(state, zip), []error{state, zip}},
+		{"wrapped join stays whole", wrapped, []error{wrapped}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Problems(tt.err))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package address_parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+// token is a word of the raw input and its byte range there, so a component built
+// from tokens knows where it was written even after normalization rewrites its value.
+type token struct {
+	text       string
+	start, end int
+}
+
+// segments splits s at commas into words, dropping empty segments. Words keep their
+// trailing punctuation ("St.") and their byte offsets in s.
+func segments(s string) [][]token {
+	var (
+		result  [][]token
+		current []token
+		start   = -1
+	)
+	endWord := func(end int) {
+		if start >= 0 {
+			current = append(current, token{text: s[start:end], start: start, end: end})
+			start = -1
+		}
+	}
+	endSegment := func() {
+		if len(current) > 0 {
+			result = append(result, current)
+			current = nil
+		}
+	}
+
+	for i, r := range s {
+		switch {
+		case r == ',':
+			endWord(i)
+			endSegment()
+		case unicode.IsSpace(r):
+			endWord(i)
+		case start < 0:
+			start = i
+		}
+	}
+	endWord(len(s))
+	endSegment()
+
+	return result
+}
+
+// joinTokens returns the words of tokens separated by single spaces.
+func joinTokens(tokens []token) string {
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = t.text
+	}
+	return strings.Join(words, " ")
+}
+
+// tokensSpan returns the text from the first to the last of tokens, as written in raw.
+func tokensSpan(raw string, tokens []token) entity.TextSpan {
+	return textSpan(raw, tokens[0].start, tokens[len(tokens)-1].end)
+}
+
+// textSpan converts the byte range [start, end) of raw to a TextSpan; offsets are in characters.
+func textSpan(raw string, start, end int) entity.TextSpan {
+	return entity.TextSpan{
+		Text:  raw[start:end],
+		Start: utf8.RuneCountInString(raw[:start]),
+		End:   utf8.RuneCountInString(raw[:end]),
+	}
+}
+
+// locateLabels finds the byte range of each labeled value in rawAddress, for parsers
+// that return labels rather than positions. Values are matched in the order given, each
+// after the previous one, so a repeated word ("Washington, Washington") resolves to the
+// occurrence that was labeled. A value that cannot be found gets a nil range.
+func locateLabels(rawAddress string, values []string) [][]int {
+	ranges := make([][]int, len(values))
+	cursor := 0
+
+	for i, value := range values {
+		pattern := wordsPattern(value)
+		if pattern == nil {
+			continue
+		}
+		loc := pattern.FindStringIndex(rawAddress[cursor:])
+		if loc == nil {
+			continue
+		}
+		ranges[i] = []int{loc[0] + cursor, loc[1] + cursor}
+		cursor += loc[1]
+	}
+
+	return ranges
+}
+
+// wordsPattern matches the words of a labeled value case-insensitively, tolerating the
+// whitespace and punctuation a parser drops from its labels ("Main St." vs "main st").
+func wordsPattern(value string) *regexp.Regexp {
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '.' || r == '#'
+	})
+	if len(words) == 0 {
+		return nil
+	}
+
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(w)
+	}
+	return regexp.MustCompile(`(?i)\b` + strings.Join(words, `[\s,.#]+`) + `\b\.?`)
+}
@@ -0,0 +1,48 @@
+package address_parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func TestSegments(t *testing.T) {
+	raw := " 123  Main St., Springfield ,, IL 62701,"
+
+	parts := segments(raw)
+
+	require.Len(t, parts, 3)
+	assert.Equal(t, []token{{"123", 1, 4}, {"Main", 6, 10}, {"St.", 11, 14}}, parts[0])
+	assert.Equal(t, []token{{"Springfield", 16, 27}}, parts[1])
+	assert.Equal(t, []token{{"IL", 31, 33}, {"62701", 34, 39}}, parts[2])
+	assert.Equal(t, "123 Main St.", joinTokens(parts[0]))
+	assert.Equal(t, entity.TextSpan{Text: "123  Main St.", Start: 1, End: 14}, tokensSpan(raw, parts[0]))
+}
+
+func TestLocateLabels(t *testing.T) {
+	t.Run("labels as a parser returns them", func(t *testing.T) {
+		raw := "123  Main St., New York, NY 10001"
+
+		ranges := locateLabels(raw, []string{"123", "main st", "new york", "ny", "10001"})
+
+		assert.Equal(t, [][]int{{0, 3}, {5, 13}, {15, 23}, {25, 27}, {28, 33}}, ranges)
+	})
+
+	t.Run("a repeated word resolves to the labeled occurrence", func(t *testing.T) {
+		raw := "1 Capitol Way, Washington, Washington"
+
+		ranges := locateLabels(raw, []string{"1", "capitol way", "washington", "washington"})
+
+		assert.Equal(t, []int{15, 25}, ranges[2])
+		assert.Equal(t, []int{27, 37}, ranges[3])
+	})
+
+	t.Run("a value that is not in the input has no range", func(t *testing.T) {
+		ranges := locateLabels("Springfield, IL", []string{"springfield", "62701", "il"})
+
+		assert.Equal(t, [][]int{{0, 11}, nil, {13, 15}}, ranges)
+	})
+}
@@ -14,6 +14,9 @@ import (
 	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
 )
 
+// ParserVersion identifies the active parser and its normalization rules; bump it when output changes.
+const ParserVersion = "gopostal/1"
+
 var titleCaser = cases.Title(language.AmericanEnglish)
 
 // GopostalParser implements ValidateAddressRepository using gopostal library.
@@ -26,36 +29,70 @@ func NewGopostalParser() *GopostalParser {
 
 // ParseAddress parses a raw address string using gopostal and returns a normalized Address.
 func (p *GopostalParser) ParseAddress(
-	_ context.Context,
+	ctx context.Context,
 	rawAddress string,
 ) (*entity.Address, []*entity.Address, error) {
+	trace := entity.DecisionTraceFromContext(ctx)
 	parsed := parser.ParseAddress(rawAddress)
 
 	components := make(map[string]string)
 	for _, comp := range parsed {
 		components[comp.Label] = comp.Value
+		trace.Addf(entity.StageParse, "libpostal_label", "", comp.Value,
+			"libpostal labeled %q as %s", comp.Value, comp.Label)
 	}
 
 	addr := &entity.Address{
-		StreetAddress:      p.buildStreet(components),
-		City:               p.normalizeCity(components),
-		State:              p.normalizeState(components),
-		PostalCode:         p.extractPostalCode(components),
-		AddressType:        p.detectAddressType(rawAddress),
-		CorrectionsApplied: p.trackCorrections(rawAddress, components),
+		StreetAddress: p.buildStreet(components),
+		City:          p.normalizeCity(components),
+		State:         p.normalizeState(components),
+		PostalCode:    p.extractPostalCode(components),
+		AddressType:   p.detectAddressType(rawAddress),
+		Evidence:      p.collectEvidence(components),
+		Components:    p.collectSpans(rawAddress, parsed),
 	}
 
-	if addr.StreetAddress == "" && addr.City == "" && addr.State == "" {
+	if addr.StreetAddress == "" && addr.City == "" && addr.State == "" && addr.PostalCode == "" {
 		return nil, nil, &domainerrors.ParsingError{
+			Code:       domainerrors.CodeAddressUnparseable,
 			Field:      "address",
 			Reason:     "Could not extract required address components",
 			Suggestion: "Ensure address contains street address, city, and state",
 		}
 	}
 
+	if state := components["state"]; state != "" && !strings.EqualFold(strings.TrimSpace(state), addr.State) {
+		trace.Addf(entity.StageParse, "state_name", entity.ComponentState, state,
+			"Converted the state %q to the USPS code %s", state, addr.State)
+	}
+
+	addr.Corrections = TrackCorrections(rawAddress, addr)
+	crossCheckZIP(addr, trace)
+
 	return addr, nil, nil
 }
 
+// ParseLabels returns libpostal's labels for rawAddress, such as house_number and road,
+// and the components buildStreet, normalizeCity, normalizeState and extractPostalCode
+// make of them.
+func (p *GopostalParser) ParseLabels(_ context.Context, rawAddress string) (*entity.ParseTrace, error) {
+	trace := &entity.ParseTrace{Parser: ParserVersion, Labels: []entity.ParseLabel{}}
+
+	components := make(map[string]string)
+	for _, comp := range parser.ParseAddress(rawAddress) {
+		trace.Labels = append(trace.Labels, entity.ParseLabel{Label: comp.Label, Value: comp.Value})
+		components[comp.Label] = comp.Value
+	}
+
+	trace.Normalized = normalizedComponents(&entity.Address{
+		StreetAddress: p.buildStreet(components),
+		City:          p.normalizeCity(components),
+		State:         p.normalizeState(components),
+		PostalCode:    p.extractPostalCode(components),
+	})
+	return trace, nil
+}
+
 func (p *GopostalParser) buildStreet(components map[string]string) string {
 	var parts []string
 
@@ -100,10 +137,71 @@ func (p *GopostalParser) extractPostalCode(components map[string]string) string
 	return ""
 }
 
-func (p *GopostalParser) detectAddressType(rawAddress string) string {
-	return DetectAddressType(rawAddress)
+// collectEvidence grades libpostal's labels: labeled tokens are exact, except states
+// given by name or in an unrecognized form, which needed a fix-up.
+func (p *GopostalParser) collectEvidence(components map[string]string) map[string]entity.Evidence {
+	evidence := make(map[string]entity.Evidence)
+
+	if components["house_number"] != "" || components["road"] != "" {
+		evidence[entity.ComponentStreetAddress] = entity.EvidenceExact
+	}
+	if components["city"] != "" {
+		evidence[entity.ComponentCity] = entity.EvidenceExact
+	}
+	if state := strings.TrimSpace(components["state"]); state != "" {
+		if entity.ValidUSStates[strings.ToUpper(state)] {
+			evidence[entity.ComponentState] = entity.EvidenceExact
+		} else {
+			evidence[entity.ComponentState] = entity.EvidenceFuzzy
+		}
+	}
+	if components["postcode"] != "" {
+		evidence[entity.ComponentPostalCode] = entity.EvidenceExact
+	}
+
+	return evidence
+}
+
+// gopostalComponentNames maps the libpostal labels the address is built from to component names.
+var gopostalComponentNames = map[string]string{
+	"house_number": entity.ComponentStreetAddress,
+	"road":         entity.ComponentStreetAddress,
+	"unit":         entity.ComponentStreetAddress,
+	"city":         entity.ComponentCity,
+	"state":        entity.ComponentState,
+	"postcode":     entity.ComponentPostalCode,
+}
+
+// collectSpans locates libpostal's labeled tokens in rawAddress, in the order it returned
+// them, and spans each component over the tokens it was built from ("123 Main Street"
+// for a street normalized to "123 Main St", "Illinois" for the state IL).
+func (p *GopostalParser) collectSpans(rawAddress string, parsed []parser.ParsedComponent) map[string]entity.TextSpan {
+	values := make([]string, len(parsed))
+	for i, comp := range parsed {
+		values[i] = comp.Value
+	}
+	ranges := locateLabels(rawAddress, values)
+
+	bounds := make(map[string][]int)
+	for i, comp := range parsed {
+		name, ok := gopostalComponentNames[comp.Label]
+		if !ok || ranges[i] == nil {
+			continue
+		}
+		if b, seen := bounds[name]; seen {
+			b[0], b[1] = min(b[0], ranges[i][0]), max(b[1], ranges[i][1])
+			continue
+		}
+		bounds[name] = ranges[i]
+	}
+
+	spans := make(map[string]entity.TextSpan, len(bounds))
+	for name, b := range bounds {
+		spans[name] = textSpan(rawAddress, b[0], b[1])
+	}
+	return spans
 }
 
-func (p *GopostalParser) trackCorrections(rawAddress string, components map[string]string) []string {
-	return TrackCorrections(rawAddress, components)
+func (p *GopostalParser) detectAddressType(rawAddress string) string {
+	return DetectAddressType(rawAddress)
 }
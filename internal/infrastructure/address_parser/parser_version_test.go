@@ -0,0 +1,22 @@
+package address_parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func TestParserVersion_PinsCanonicalKeyVersion(t *testing.T) {
+	// Canonical keys are built from parser output, so a parser whose output changes
+	// changes the keys too. When this fails, ParserVersion was bumped: decide whether
+	// entity.CanonicalKeyVersion must be bumped as well and record the pair here.
+	keyVersions := map[string]string{
+		"regex/1":    "v1",
+		"gopostal/1": "v1",
+	}
+
+	assert.Equal(t, keyVersions[ParserVersion], entity.CanonicalKeyVersion,
+		"ParserVersion %s has no recorded CanonicalKeyVersion", ParserVersion)
+}
@@ -14,6 +14,9 @@ import (
 	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
 )
 
+// ParserVersion identifies the active parser and its normalization rules; bump it when output changes.
+const ParserVersion = "regex/1"
+
 var (
 	titleCaser = cases.Title(language.AmericanEnglish)
 	zipPattern = regexp.MustCompile(`\b(\d{5}(?:-\d{4})?)\b`)
@@ -31,70 +34,119 @@ func NewGopostalParser() *GopostalParser {
 
 // ParseAddress parses a raw address string using regex-based parsing.
 func (p *GopostalParser) ParseAddress(
-	_ context.Context,
+	ctx context.Context,
 	rawAddress string,
 ) (primary *entity.Address, candidates []*entity.Address, err error) {
-	cleaned := normalizeWhitespace(rawAddress)
+	trace := entity.DecisionTraceFromContext(ctx)
 
-	components := p.extractComponents(cleaned)
+	labels, evidence, spans := p.extractComponents(rawAddress, trace)
+	components := p.normalizeComponents(labels)
+	traceNormalization(trace, labels, components)
 
 	addr := &entity.Address{
-		StreetAddress:      components["street"],
-		City:               components["city"],
-		State:              components["state"],
-		PostalCode:         components["postal_code"],
-		AddressType:        DetectAddressType(rawAddress),
-		CorrectionsApplied: TrackCorrections(rawAddress, components),
+		StreetAddress: components["street"],
+		City:          components["city"],
+		State:         components["state"],
+		PostalCode:    components["postal_code"],
+		AddressType:   DetectAddressType(rawAddress),
+		Evidence:      evidence,
+		Components:    spans,
 	}
 
-	if addr.StreetAddress == "" && addr.City == "" && addr.State == "" {
+	if addr.StreetAddress == "" && addr.City == "" && addr.State == "" && addr.PostalCode == "" {
 		return nil, nil, &domainerrors.ParsingError{
+			Code:       domainerrors.CodeAddressUnparseable,
 			Field:      "address",
 			Reason:     "Could not extract required address components",
 			Suggestion: "Ensure address contains street address, city, and state",
 		}
 	}
 
+	addr.Corrections = TrackCorrections(rawAddress, addr)
+	crossCheckZIP(addr, trace)
+
 	return addr, nil, nil
 }
 
-func (p *GopostalParser) extractComponents(address string) map[string]string {
+// regexLabels lists the labels extractComponents produces, in address order.
+var regexLabels = []string{"street", "city", "state", "postal_code"}
+
+// ParseLabels returns the components extractComponents labels in rawAddress and what
+// normalizeComponents makes of them.
+func (p *GopostalParser) ParseLabels(_ context.Context, rawAddress string) (*entity.ParseTrace, error) {
+	labels, _, _ := p.extractComponents(rawAddress, nil)
+
+	trace := &entity.ParseTrace{Parser: ParserVersion, Labels: []entity.ParseLabel{}}
+	for _, label := range regexLabels {
+		if value, ok := labels[label]; ok {
+			trace.Labels = append(trace.Labels, entity.ParseLabel{Label: label, Value: value})
+		}
+	}
+
+	components := p.normalizeComponents(labels)
+	trace.Normalized = normalizedComponents(&entity.Address{
+		StreetAddress: components["street"],
+		City:          components["city"],
+		State:         components["state"],
+		PostalCode:    components["postal_code"],
+	})
+	return trace, nil
+}
+
+// extractComponents splits the address into the labels in regexLabels, as written,
+// recording each rule that places a component in trace. Each component's span is taken
+// from the words it was built from, so it points at the input as written.
+func (p *GopostalParser) extractComponents(
+	address string,
+	trace *entity.DecisionTrace,
+) (map[string]string, map[string]entity.Evidence, map[string]entity.TextSpan) {
 	components := make(map[string]string)
+	evidence := make(map[string]entity.Evidence)
+	spans := make(map[string]entity.TextSpan)
 	remaining := address
 
-	// Extract ZIP code
-	if match := zipPattern.FindString(remaining); match != "" {
+	// Extract ZIP code; blanking it keeps every other word at its offset in address
+	if loc := zipPattern.FindStringIndex(remaining); loc != nil {
+		match := remaining[loc[0]:loc[1]]
 		components["postal_code"] = match
-		remaining = strings.Replace(remaining, match, "", 1)
+		evidence[entity.ComponentPostalCode] = entity.EvidenceExact
+		spans[entity.ComponentPostalCode] = textSpan(address, loc[0], loc[1])
+		remaining = remaining[:loc[0]] + strings.Repeat(" ", loc[1]-loc[0]) + remaining[loc[1]:]
+		trace.Addf(entity.StageParse, "zip_token", entity.ComponentPostalCode, match,
+			"Took %s as the ZIP code: it is the first token shaped like 12345 or 12345-6789", match)
 	}
 
-	// Clean up remaining after ZIP removal
-	remaining = normalizeWhitespace(remaining)
-
-	// Remove trailing/leading commas and clean up
-	remaining = strings.Trim(remaining, ", ")
-
-	// Split by comma or multiple spaces
-	parts := splitAddress(remaining)
+	// Split by comma or at the street suffix
+	parts := splitAddress(remaining, trace)
 
 	if len(parts) == 0 {
-		return components
+		return components, evidence, spans
+	}
+
+	// Without commas, street and city boundaries are guessed from word positions
+	placement := entity.EvidenceExact
+	if len(segments(remaining)) == 1 {
+		placement = entity.EvidenceSegmented
+		trace.Addf(entity.StageParse, "no_commas", "", "",
+			"The address has no commas, so the street and city are placed by word position and get segmented evidence")
 	}
 
 	// Try to identify state (last 2-letter word that matches a state code)
 	for i := len(parts) - 1; i >= 0; i-- {
-		part := strings.TrimSpace(parts[i])
-		words := strings.Fields(part)
+		words := parts[i]
 
 		for j := len(words) - 1; j >= 0; j-- {
-			word := strings.ToUpper(words[j])
+			word := strings.ToUpper(words[j].text)
 			if len(word) == 2 && entity.ValidUSStates[word] {
 				components["state"] = word
+				evidence[entity.ComponentState] = entity.EvidenceExact
+				spans[entity.ComponentState] = tokensSpan(address, words[j:j+1])
+				trace.Addf(entity.StageParse, "state_code", entity.ComponentState, words[j].text,
+					"Took %s as the state: it is the last 2-letter word that is a USPS state code", words[j].text)
 
 				// Remove state from parts
-				words = append(words[:j], words[j+1:]...)
-				parts[i] = strings.Join(words, " ")
-				if strings.TrimSpace(parts[i]) == "" {
+				parts[i] = append(words[:j:j], words[j+1:]...)
+				if len(parts[i]) == 0 {
 					parts = append(parts[:i], parts[i+1:]...)
 				}
 				goto stateFound
@@ -102,9 +154,14 @@ func (p *GopostalParser) extractComponents(address string) map[string]string {
 		}
 
 		// Try full state names
+		part := joinTokens(words)
 		for name, code := range stateNameToCode {
-			if strings.EqualFold(strings.TrimSpace(part), name) {
+			if strings.EqualFold(part, name) {
 				components["state"] = code
+				evidence[entity.ComponentState] = entity.EvidenceFuzzy
+				spans[entity.ComponentState] = tokensSpan(address, words)
+				trace.Addf(entity.StageParse, "state_name", entity.ComponentState, part,
+					"Took segment %q as the state: it is the name of %s, so the state gets fuzzy evidence", part, code)
 				parts = append(parts[:i], parts[i+1:]...)
 				goto stateFound
 			}
@@ -113,76 +170,112 @@ func (p *GopostalParser) extractComponents(address string) map[string]string {
 
 stateFound:
 
+	place := func(label string, words []token) {
+		components[label] = joinTokens(words)
+		evidence[regexComponentNames[label]] = placement
+		spans[regexComponentNames[label]] = tokensSpan(address, words)
+	}
+
 	// Assign remaining parts
 	switch len(parts) {
 	case 0:
 		// Nothing left
 	case 1:
-		part := strings.TrimSpace(parts[0])
+		part := joinTokens(parts[0])
 		if looksLikeStreet(part) {
-			components["street"] = titleCaser.String(strings.ToLower(part))
+			place("street", parts[0])
+			trace.Addf(entity.StageParse, "single_segment", entity.ComponentStreetAddress, part,
+				"Took the only remaining segment %q as the street because it starts with a digit", part)
 		} else {
-			components["city"] = titleCaser.String(strings.ToLower(part))
+			place("city", parts[0])
+			trace.Addf(entity.StageParse, "single_segment", entity.ComponentCity, part,
+				"Took the only remaining segment %q as the city because it does not start with a digit", part)
 		}
-	case 2:
-		components["street"] = titleCaser.String(strings.ToLower(strings.TrimSpace(parts[0])))
-		components["city"] = titleCaser.String(strings.ToLower(strings.TrimSpace(parts[1])))
 	default:
 		// First part is street, second is city, rest might be additional info
-		components["street"] = titleCaser.String(strings.ToLower(strings.TrimSpace(parts[0])))
-		components["city"] = titleCaser.String(strings.ToLower(strings.TrimSpace(parts[1])))
+		place("street", parts[0])
+		place("city", parts[1])
+		traceStreetAndCity(trace, components, parts[2:])
 	}
 
+	return components, evidence, spans
+}
+
+// normalizeComponents title-cases the street and city; the state is already a USPS
+// code and the ZIP code needs no normalization.
+func (p *GopostalParser) normalizeComponents(labels map[string]string) map[string]string {
+	components := make(map[string]string, len(labels))
+	for label, value := range labels {
+		if label == "street" || label == "city" {
+			value = titleCaser.String(strings.ToLower(value))
+		}
+		components[label] = value
+	}
 	return components
 }
 
-func splitAddress(s string) []string {
-	// Split by comma first
-	if strings.Contains(s, ",") {
-		parts := strings.Split(s, ",")
-		var result []string
-		for _, p := range parts {
-			trimmed := strings.TrimSpace(p)
-			if trimmed != "" {
-				result = append(result, trimmed)
-			}
+// traceStreetAndCity records that the first two segments became the street and city,
+// and which later segments were ignored.
+func traceStreetAndCity(trace *entity.DecisionTrace, components map[string]string, ignored [][]token) {
+	trace.Addf(entity.StageParse, "first_segment", entity.ComponentStreetAddress, components["street"],
+		"Took the first segment %q as the street", components["street"])
+	trace.Addf(entity.StageParse, "second_segment", entity.ComponentCity, components["city"],
+		"Took the second segment %q as the city", components["city"])
+	for _, words := range ignored {
+		part := joinTokens(words)
+		trace.Addf(entity.StageParse, "extra_segment", "", part,
+			"Ignored segment %q: only the first two segments are read as street and city", part)
+	}
+}
+
+// traceNormalization records the components normalizeComponents changed.
+func traceNormalization(trace *entity.DecisionTrace, labels, components map[string]string) {
+	for _, label := range regexLabels {
+		if labels[label] != components[label] {
+			trace.Addf(entity.StageParse, "title_case", regexComponentNames[label], components[label],
+				"Title-cased %q to %q", labels[label], components[label])
 		}
-		return result
+	}
+}
+
+// regexComponentNames maps the labels in regexLabels to component names.
+var regexComponentNames = map[string]string{
+	"street":      entity.ComponentStreetAddress,
+	"city":        entity.ComponentCity,
+	"state":       entity.ComponentState,
+	"postal_code": entity.ComponentPostalCode,
+}
+
+func splitAddress(s string, trace *entity.DecisionTrace) [][]token {
+	// Split by comma first
+	parts := segments(s)
+	if len(parts) != 1 {
+		return parts
 	}
 
 	// No commas: try to split intelligently
 	// Look for pattern: "street city state zip"
 	// The street typically starts with a number
-	words := strings.Fields(s)
+	words := parts[0]
 	if len(words) <= 1 {
-		return words
+		return parts
 	}
 
 	// Find where the city starts - after street type abbreviation or at a word boundary
 	streetEnd := findStreetEnd(words)
 	if streetEnd > 0 && streetEnd < len(words) {
-		street := strings.Join(words[:streetEnd], " ")
-		city := strings.Join(words[streetEnd:], " ")
-		return []string{street, city}
+		trace.Addf(entity.StageParse, "suffix_boundary", entity.ComponentStreetAddress, words[streetEnd-1].text,
+			"Ended the street after %q, the first street suffix word; the words after it are the city", words[streetEnd-1].text)
+		return [][]token{words[:streetEnd], words[streetEnd:]}
 	}
 
-	return []string{s}
-}
-
-var streetSuffixes = map[string]bool{
-	"st": true, "street": true, "ave": true, "avenue": true,
-	"blvd": true, "boulevard": true, "dr": true, "drive": true,
-	"ln": true, "lane": true, "rd": true, "road": true,
-	"ct": true, "court": true, "pl": true, "place": true,
-	"way": true, "cir": true, "circle": true, "pkwy": true,
-	"parkway": true, "ter": true, "terrace": true, "trl": true,
-	"trail": true, "hwy": true, "highway": true,
+	return parts
 }
 
-func findStreetEnd(words []string) int {
+func findStreetEnd(words []token) int {
 	for i, word := range words {
-		lower := strings.ToLower(strings.TrimRight(word, ".,"))
-		if streetSuffixes[lower] {
+		lower := strings.ToLower(strings.TrimRight(word.text, ".,"))
+		if entity.IsStreetSuffix(lower) {
 			return i + 1
 		}
 	}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
 )
 
 func TestGopostalParser_ParseAddress(t *testing.T) {
@@ -109,6 +111,213 @@ func TestGopostalParser_ParseAddress(t *testing.T) {
 	}
 }
 
+func TestGopostalParser_Evidence(t *testing.T) {
+	parser := NewGopostalParser()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]entity.Evidence
+	}{
+		{
+			name:  "comma-delimited address agreeing with ZIP reference data",
+			input: "123 Main St, New York, NY 10001",
+			expected: map[string]entity.Evidence{
+				entity.ComponentStreetAddress: entity.EvidenceExact,
+				entity.ComponentCity:          entity.EvidenceExact,
+				entity.ComponentState:         entity.EvidenceReference,
+				entity.ComponentPostalCode:    entity.EvidenceReference,
+			},
+		},
+		{
+			name:  "undelimited address is segmented",
+			input: "123 Main St New York NY",
+			expected: map[string]entity.Evidence{
+				entity.ComponentStreetAddress: entity.EvidenceSegmented,
+				entity.ComponentCity:          entity.EvidenceSegmented,
+				entity.ComponentState:         entity.EvidenceExact,
+			},
+		},
+		{
+			name:  "full state name is a fuzzy fix",
+			input: "456 Oak Ave, Austin, Texas",
+			expected: map[string]entity.Evidence{
+				entity.ComponentStreetAddress: entity.EvidenceExact,
+				entity.ComponentCity:          entity.EvidenceExact,
+				entity.ComponentState:         entity.EvidenceFuzzy,
+			},
+		},
+		{
+			name:  "ZIP from another state conflicts",
+			input: "789 Pine Rd, Chicago, IL 10001",
+			expected: map[string]entity.Evidence{
+				entity.ComponentStreetAddress: entity.EvidenceExact,
+				entity.ComponentCity:          entity.EvidenceExact,
+				entity.ComponentState:         entity.EvidenceExact,
+				entity.ComponentPostalCode:    entity.EvidenceConflict,
+			},
+		},
+		{
+			name:  "ZIP missing from the dataset is unknown, not a conflict",
+			input: "789 Pine Rd, Spokane, WA 83814",
+			expected: map[string]entity.Evidence{
+				entity.ComponentStreetAddress: entity.EvidenceExact,
+				entity.ComponentCity:          entity.EvidenceExact,
+				entity.ComponentState:         entity.EvidenceExact,
+				entity.ComponentPostalCode:    entity.EvidenceExact,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, _, err := parser.ParseAddress(context.Background(), tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, addr.Evidence)
+		})
+	}
+}
+
+func TestGopostalParser_Components(t *testing.T) {
+	parser := NewGopostalParser()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]entity.TextSpan
+	}{
+		{
+			name:  "spans point at the input as written",
+			input: "123  main street., springfield, illinois 62701",
+			expected: map[string]entity.TextSpan{
+				entity.ComponentStreetAddress: {Text: "123  main street.", Start: 0, End: 17},
+				entity.ComponentCity:          {Text: "springfield", Start: 19, End: 30},
+				entity.ComponentState:         {Text: "illinois", Start: 32, End: 40},
+				entity.ComponentPostalCode:    {Text: "62701", Start: 41, End: 46},
+			},
+		},
+		{
+			name:  "repeated words resolve to the token that was parsed",
+			input: "5 Springfield Rd, Springfield, IL",
+			expected: map[string]entity.TextSpan{
+				entity.ComponentStreetAddress: {Text: "5 Springfield Rd", Start: 0, End: 16},
+				entity.ComponentCity:          {Text: "Springfield", Start: 18, End: 29},
+				entity.ComponentState:         {Text: "IL", Start: 31, End: 33},
+			},
+		},
+		{
+			name:  "city named like its state",
+			input: "1 Capitol Way, Washington, Washington",
+			expected: map[string]entity.TextSpan{
+				entity.ComponentStreetAddress: {Text: "1 Capitol Way", Start: 0, End: 13},
+				entity.ComponentCity:          {Text: "Washington", Start: 15, End: 25},
+				entity.ComponentState:         {Text: "Washington", Start: 27, End: 37},
+			},
+		},
+		{
+			name:  "ZIP code written before the state",
+			input: "12 Peña Blvd Denver 80202 CO",
+			expected: map[string]entity.TextSpan{
+				entity.ComponentStreetAddress: {Text: "12 Peña Blvd", Start: 0, End: 12},
+				entity.ComponentCity:          {Text: "Denver", Start: 13, End: 19},
+				entity.ComponentState:         {Text: "CO", Start: 26, End: 28},
+				entity.ComponentPostalCode:    {Text: "80202", Start: 20, End: 25},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, _, err := parser.ParseAddress(context.Background(), tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, addr.Components)
+		})
+	}
+}
+
+func TestGopostalParser_ParseLabels(t *testing.T) {
+	parser := NewGopostalParser()
+
+	tests := []struct {
+		name             string
+		input            string
+		expectLabels     []entity.ParseLabel
+		expectNormalized map[string]string
+	}{
+		{
+			name:  "labels keep the input's case",
+			input: "123 main st, springfield, il 62701",
+			expectLabels: []entity.ParseLabel{
+				{Label: "street", Value: "123 main st"},
+				{Label: "city", Value: "springfield"},
+				{Label: "state", Value: "IL"},
+				{Label: "postal_code", Value: "62701"},
+			},
+			expectNormalized: map[string]string{
+				entity.ComponentStreetAddress: "123 Main St",
+				entity.ComponentCity:          "Springfield",
+				entity.ComponentState:         "IL",
+				entity.ComponentPostalCode:    "62701",
+			},
+		},
+		{
+			name:  "missing components are left out",
+			input: "Boise, Idaho",
+			expectLabels: []entity.ParseLabel{
+				{Label: "city", Value: "Boise"},
+				{Label: "state", Value: "ID"},
+			},
+			expectNormalized: map[string]string{
+				entity.ComponentCity:  "Boise",
+				entity.ComponentState: "ID",
+			},
+		},
+		{
+			name:             "nothing recognized",
+			input:            "   ",
+			expectLabels:     []entity.ParseLabel{},
+			expectNormalized: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trace, err := parser.ParseLabels(context.Background(), tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, ParserVersion, trace.Parser)
+			assert.Equal(t, tt.expectLabels, trace.Labels)
+			assert.Equal(t, tt.expectNormalized, trace.Normalized)
+		})
+	}
+}
+
+func TestGopostalParser_DecisionTrace(t *testing.T) {
+	parser := NewGopostalParser()
+	trace := &entity.DecisionTrace{}
+
+	_, _, err := parser.ParseAddress(entity.ContextWithDecisionTrace(context.Background(), trace), "123 main st springfield il 62701")
+	require.NoError(t, err)
+
+	var rules []string
+	for _, d := range trace.Decisions() {
+		rules = append(rules, d.Rule)
+	}
+	assert.Equal(t, []string{
+		"zip_token", "suffix_boundary", "no_commas", "state_code",
+		"first_segment", "second_segment", "title_case", "title_case", "zip_prefix",
+	}, rules)
+
+	decisions := trace.Decisions()
+	assert.Equal(t, "62701", decisions[0].Value)
+	assert.Equal(t, "st", decisions[1].Value)
+	assert.Equal(t, entity.ComponentCity, decisions[5].Component)
+	assert.Equal(t, "springfield", decisions[5].Value)
+	assert.Contains(t, decisions[8].Detail, "matching the state")
+}
+
 func TestDetectAddressType(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -130,18 +339,66 @@ func TestDetectAddressType(t *testing.T) {
 }
 
 func TestTrackCorrections(t *testing.T) {
-	t.Run("detects whitespace normalization", func(t *testing.T) {
-		corrections := TrackCorrections("  123 Main St  ", map[string]string{"road": "Main St"})
-		assert.Contains(t, corrections, "Normalized whitespace")
-	})
-
-	t.Run("detects capitalization correction", func(t *testing.T) {
-		corrections := TrackCorrections("123 main st", map[string]string{"road": "main st"})
-		assert.Contains(t, corrections, "Standardized capitalization")
-	})
-
-	t.Run("no corrections for clean input", func(t *testing.T) {
-		corrections := TrackCorrections("123 Main St", map[string]string{"road": "Main St"})
-		assert.Empty(t, corrections)
-	})
+	tests := []struct {
+		name     string
+		input    string
+		addr     *entity.Address
+		expected []entity.Correction
+	}{
+		{
+			name:  "whitespace normalization",
+			input: "  123 Main St  ",
+			addr:  &entity.Address{StreetAddress: "123 Main St"},
+			expected: []entity.Correction{
+				{Original: "  123 Main St  ", Corrected: "123 Main St", Type: entity.CorrectionWhitespace, Reason: "Removed leading, trailing and repeated whitespace"},
+			},
+		},
+		{
+			name:  "capitalization per component",
+			input: "123 main st, springfield, il",
+			addr:  &entity.Address{StreetAddress: "123 Main St", City: "Springfield", State: "IL"},
+			expected: []entity.Correction{
+				{Component: entity.ComponentStreetAddress, Original: "123 main st", Corrected: "123 Main St", Type: entity.CorrectionCapitalization, Reason: "Re-cased to the standard capitalization"},
+				{Component: entity.ComponentCity, Original: "springfield", Corrected: "Springfield", Type: entity.CorrectionCapitalization, Reason: "Re-cased to the standard capitalization"},
+			},
+		},
+		{
+			name:  "all-caps input is not re-cased",
+			input: "123 MAIN ST, SPRINGFIELD, IL 62701",
+			addr:  &entity.Address{StreetAddress: "123 Main St", City: "Springfield", State: "IL", PostalCode: "62701"},
+		},
+		{
+			name:  "mixed-case words are kept as written",
+			input: "PO Box 12, McDonald, PA",
+			addr:  &entity.Address{StreetAddress: "Po Box 12", City: "Mcdonald", State: "PA"},
+		},
+		{
+			name:  "state name",
+			input: "Boise, Idaho",
+			addr:  &entity.Address{City: "Boise", State: "ID"},
+			expected: []entity.Correction{
+				{Component: entity.ComponentState, Original: "Idaho", Corrected: "ID", Type: entity.CorrectionStateName, Reason: "Replaced the state name with its USPS code"},
+			},
+		},
+		{
+			name:  "punctuation alone is not a correction",
+			input: "123 Main St., Springfield",
+			addr:  &entity.Address{StreetAddress: "123 Main St", City: "Springfield"},
+		},
+		{
+			name:  "no corrections for clean input",
+			input: "123 Main St",
+			addr:  &entity.Address{StreetAddress: "123 Main St"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, _, err := NewGopostalParser().ParseAddress(context.Background(), tt.input)
+			require.NoError(t, err)
+			tt.addr.Components = parsed.Components
+
+			assert.Equal(t, tt.expected, TrackCorrections(tt.input, tt.addr))
+		})
+	}
 }
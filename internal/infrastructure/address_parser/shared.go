@@ -1,6 +1,11 @@
 package address_parser
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
+)
 
 // stateNameToCode maps full state names to their 2-letter codes.
 var stateNameToCode = map[string]string{
@@ -19,6 +24,46 @@ var stateNameToCode = map[string]string{
 	"west virginia": "WV", "wisconsin": "WI", "wyoming": "WY",
 }
 
+// crossCheckZIP compares the parsed state with the state the ZIP code is assigned to.
+// Agreement upgrades both components to reference evidence. Disagreement marks the ZIP
+// as conflicting, but only for ZIP codes in the reference dataset: the prefix ranges are
+// coarse, so a ZIP code the dataset does not list is unknown rather than wrong.
+func crossCheckZIP(addr *entity.Address, trace *entity.DecisionTrace) {
+	if addr.PostalCode == "" || addr.State == "" {
+		return
+	}
+
+	zipState, listed := reference.DatasetState(addr.PostalCode)
+	if !listed {
+		var ok bool
+		if zipState, ok = reference.StateForZIP(addr.PostalCode); !ok {
+			trace.Addf(entity.StageReference, "zip_prefix", entity.ComponentPostalCode, addr.PostalCode,
+				"ZIP code %s is in no known prefix range, so the state could not be cross-checked", addr.PostalCode)
+			return
+		}
+	}
+
+	if addr.Evidence == nil {
+		addr.Evidence = make(map[string]entity.Evidence)
+	}
+
+	switch {
+	case zipState == addr.State:
+		addr.Evidence[entity.ComponentPostalCode] = entity.EvidenceReference
+		addr.Evidence[entity.ComponentState] = entity.EvidenceReference
+		trace.Addf(entity.StageReference, "zip_prefix", entity.ComponentPostalCode, addr.PostalCode,
+			"ZIP code %s is assigned to %s, matching the state, so both get reference evidence", addr.PostalCode, zipState)
+	case listed:
+		addr.Evidence[entity.ComponentPostalCode] = entity.EvidenceConflict
+		trace.Addf(entity.StageReference, "zip_prefix", entity.ComponentPostalCode, addr.PostalCode,
+			"ZIP code %s is assigned to %s, not %s, so the ZIP code gets conflict evidence", addr.PostalCode, zipState, addr.State)
+	default:
+		trace.Addf(entity.StageReference, "zip_prefix", entity.ComponentPostalCode, addr.PostalCode,
+			"ZIP code %s is not in the reference dataset and its prefix points to %s, not %s; an unknown ZIP code is not a conflict",
+			addr.PostalCode, zipState, addr.State)
+	}
+}
+
 // DetectAddressType classifies an address based on its content.
 func DetectAddressType(rawAddress string) string {
 	lower := strings.ToLower(rawAddress)
@@ -35,32 +80,98 @@ func DetectAddressType(rawAddress string) string {
 	return "standard_street"
 }
 
-// TrackCorrections identifies normalization corrections applied to the input.
-func TrackCorrections(rawAddress string, _ map[string]string) []string {
-	var corrections []string
+// TrackCorrections lists the normalizations that turned rawAddress into addr: whitespace
+// cleanup of the whole input, then per component, in address order, re-casing and state
+// names replaced by their code. addr.Components must already be located in rawAddress.
+func TrackCorrections(rawAddress string, addr *entity.Address) []entity.Correction {
+	var corrections []entity.Correction
 
-	if rawAddress != strings.TrimSpace(rawAddress) || strings.Contains(rawAddress, "  ") {
-		corrections = append(corrections, "Normalized whitespace")
+	if cleaned := normalizeWhitespace(rawAddress); cleaned != rawAddress {
+		corrections = append(corrections, entity.Correction{
+			Original:  rawAddress,
+			Corrected: cleaned,
+			Type:      entity.CorrectionWhitespace,
+			Reason:    "Removed leading, trailing and repeated whitespace",
+		})
 	}
 
-	// Check if any word (excluding numbers, punctuation, and state codes) starts with lowercase
-	// This indicates capitalization normalization was needed
-	words := strings.Fields(rawAddress)
-	for _, word := range words {
-		cleaned := strings.Trim(word, ",.;:")
-		if cleaned == "" {
+	values := normalizedComponents(addr)
+	for _, name := range []string{entity.ComponentStreetAddress, entity.ComponentCity, entity.ComponentState} {
+		span, ok := addr.Components[name]
+		if !ok {
 			continue
 		}
-		// Skip numbers and 2-letter state codes (they get uppercased separately)
-		if len(cleaned) <= 2 {
-			continue
-		}
-		firstChar := rune(cleaned[0])
-		if firstChar >= 'a' && firstChar <= 'z' {
-			corrections = append(corrections, "Standardized capitalization")
-			break
+		if c, ok := componentCorrection(name, span.Text, values[name]); ok {
+			corrections = append(corrections, c)
 		}
 	}
 
 	return corrections
 }
+
+// componentCorrection compares a component as written with its normalized value.
+// Whitespace and punctuation differences are left to the whole-input whitespace correction.
+// A case-only difference counts only when the component was written in lowercase: input
+// that is already capitalized, such as "123 MAIN ST" or "PO Box", is left as written.
+func componentCorrection(name, written, value string) (entity.Correction, bool) {
+	original, normalized := foldSeparators(written), foldSeparators(value)
+	switch {
+	case original == normalized:
+		return entity.Correction{}, false
+	case strings.EqualFold(original, normalized):
+		if !hasLowercaseWord(written) {
+			return entity.Correction{}, false
+		}
+		return entity.Correction{
+			Component: name,
+			Original:  written,
+			Corrected: value,
+			Type:      entity.CorrectionCapitalization,
+			Reason:    "Re-cased to the standard capitalization",
+		}, true
+	case name == entity.ComponentState && stateNameToCode[strings.ToLower(original)] == value:
+		return entity.Correction{
+			Component: name,
+			Original:  written,
+			Corrected: value,
+			Type:      entity.CorrectionStateName,
+			Reason:    "Replaced the state name with its USPS code",
+		}, true
+	}
+	return entity.Correction{}, false
+}
+
+// hasLowercaseWord reports whether any word of s longer than two letters starts with a
+// lowercase letter. Shorter words are skipped, as two-letter state codes are upper-cased anyway.
+func hasLowercaseWord(s string) bool {
+	for _, word := range strings.Fields(s) {
+		word = strings.Trim(word, ",.;:")
+		if len(word) > 2 && word[0] >= 'a' && word[0] <= 'z' {
+			return true
+		}
+	}
+	return false
+}
+
+// foldSeparators joins the words of s with single spaces, dropping the punctuation wordsPattern tolerates.
+func foldSeparators(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '.' || r == '#'
+	}), " ")
+}
+
+// normalizedComponents keys the components addr has by component name, for a ParseTrace.
+func normalizedComponents(addr *entity.Address) map[string]string {
+	normalized := make(map[string]string)
+	for name, value := range map[string]string{
+		entity.ComponentStreetAddress: addr.StreetAddress,
+		entity.ComponentCity:          addr.City,
+		entity.ComponentState:         addr.State,
+		entity.ComponentPostalCode:    addr.PostalCode,
+	} {
+		if value != "" {
+			normalized[name] = value
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,123 @@
+package address_parser
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+// SpanFinder implements AddressSpanFinder using a pattern anchored on a street line
+// (house number + street suffix, or PO Box) followed by a city and a US state.
+type SpanFinder struct {
+	pattern *regexp.Regexp
+}
+
+// NewSpanFinder creates a new SpanFinder.
+func NewSpanFinder() *SpanFinder {
+	return &SpanFinder{pattern: buildSpanPattern()}
+}
+
+// FindAddressSpans returns every address-like span in text, in order of appearance.
+// A candidate whose street name holds sentence filler, as in "2 cats in the park on
+// Main St", is prose rather than an address; the search resumes after its number.
+func (f *SpanFinder) FindAddressSpans(text string) []entity.TextSpan {
+	var spans []entity.TextSpan
+	for offset := 0; offset < len(text); {
+		m := f.pattern.FindStringSubmatchIndex(text[offset:])
+		if m == nil {
+			break
+		}
+
+		start, end := offset+m[0], offset+m[1]
+		if m[2] >= 0 && hasFillerWord(text[offset+m[2]:offset+m[3]]) {
+			// The name starts with a space, so resuming there cannot split a word.
+			offset += m[2]
+			continue
+		}
+		offset = end
+
+		for end > start && strings.ContainsRune(" \t\r\n,;.", rune(text[end-1])) {
+			end--
+		}
+
+		spans = append(spans, entity.TextSpan{
+			Text:  text[start:end],
+			Start: utf8.RuneCountInString(text[:start]),
+			End:   utf8.RuneCountInString(text[:end]),
+		})
+	}
+
+	return spans
+}
+
+// fillerWords are words of running prose that never appear in a street name. "of" and
+// "the" are left out for names such as "Avenue of the Americas".
+var fillerWords = map[string]bool{
+	"a": true, "an": true, "and": true, "or": true, "but": true, "in": true, "on": true,
+	"at": true, "to": true, "for": true, "from": true, "with": true, "by": true, "into": true,
+	"is": true, "are": true, "was": true, "were": true, "be": true, "has": true, "have": true, "had": true,
+	"i": true, "we": true, "you": true, "he": true, "she": true, "it": true, "they": true,
+	"my": true, "our": true, "your": true, "his": true, "her": true, "its": true, "their": true,
+	"this": true, "that": true, "these": true, "those": true,
+}
+
+// hasFillerWord reports whether any word of a street name is sentence filler.
+func hasFillerWord(name string) bool {
+	for _, word := range strings.Fields(name) {
+		if fillerWords[strings.ToLower(strings.Trim(word, ".'-"))] {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSpanPattern matches a street line, an optional unit, a city, a state and an
+// optional ZIP. Submatch 1 is the street name between the house number and the suffix.
+//
+// The name words hold no digits other than ordinals ("31st"), so the house number is
+// the one directly before them and not a stray number earlier in the sentence. A city
+// followed by a 2-letter state code and a ZIP is tried first, so "Washington, DC 20500"
+// is read whole instead of stopping at the state name Washington.
+func buildSpanPattern() *regexp.Regexp {
+	suffixes := sortedByLength(entity.StreetSuffixes())
+	stateNames := sortedByLength(keys(stateNameToCode))
+	for i, name := range stateNames {
+		stateNames[i] = strings.ReplaceAll(regexp.QuoteMeta(name), " ", `\s+`)
+	}
+	stateCodes := keys(entity.ValidUSStates)
+	sort.Strings(stateCodes)
+
+	nameWord := `(?:[A-Za-z][A-Za-z.'-]*|\d+(?:st|nd|rd|th))`
+	street := `(?:\d{1,6}[A-Za-z]?((?:\s+` + nameWord + `){1,6}?)\s+(?:` + strings.Join(suffixes, "|") + `)\b\.?` +
+		`(?:\s+(?:NE|NW|SE|SW|N|S|E|W)\b\.?)?` +
+		`|P\.?\s?O\.?\s+Box\s+\d+)`
+	unit := `(?:\s*,?\s*(?:apt|suite|ste|unit|#)\.?\s*[A-Za-z0-9-]+)?`
+	city := `\s*,?\s*(?:[A-Za-z.'-]+\s+){0,3}?[A-Za-z.'-]+\s*,?\s*`
+	stateCode := `(?-i:` + strings.Join(stateCodes, "|") + `)\b`
+	state := `(?:` + stateCode + `|(?:` + strings.Join(stateNames, "|") + `)\b)`
+	zip := `\s*\d{5}(?:-\d{4})?\b`
+
+	return regexp.MustCompile(`(?i)\b` + street + unit + `(?:` + city + stateCode + zip + `|` + city + state + `(?:` + zip + `)?)`)
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+// sortedByLength orders alternatives longest first so the regex prefers "street" over "st".
+func sortedByLength(values []string) []string {
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	return values
+}
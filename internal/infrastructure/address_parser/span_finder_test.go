@@ -0,0 +1,89 @@
+package address_parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpanFinder_FindAddressSpans(t *testing.T) {
+	finder := NewSpanFinder()
+
+	t.Run("finds multiple addresses with offsets", func(t *testing.T) {
+		text := "Please ship to 123 Main St, Springfield, IL 62701 by Friday. " +
+			"Returns go to PO Box 45, Boise, ID 83702."
+
+		spans := finder.FindAddressSpans(text)
+
+		require.Len(t, spans, 2)
+		assert.Equal(t, "123 Main St, Springfield, IL 62701", spans[0].Text)
+		assert.Equal(t, 15, spans[0].Start)
+		assert.Equal(t, 49, spans[0].End)
+		assert.Equal(t, "PO Box 45, Boise, ID 83702", spans[1].Text)
+		assert.Equal(t, spans[1].Text, text[spans[1].Start:spans[1].End])
+	})
+
+	t.Run("offsets count characters, not bytes", func(t *testing.T) {
+		text := "Olá, envie para 456 Oak Ave, Los Angeles, CA 90210"
+
+		spans := finder.FindAddressSpans(text)
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "456 Oak Ave, Los Angeles, CA 90210", spans[0].Text)
+		assert.Equal(t, 16, spans[0].Start)
+		assert.Equal(t, 50, spans[0].End)
+	})
+
+	t.Run("address split across lines", func(t *testing.T) {
+		spans := finder.FindAddressSpans("Send it to:\n789 Pine Rd\nChicago, Illinois 60601\nThanks")
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "789 Pine Rd\nChicago, Illinois 60601", spans[0].Text)
+	})
+
+	t.Run("a number earlier in the sentence is not the house number", func(t *testing.T) {
+		spans := finder.FindAddressSpans("12345 shipped to 123 Main St, Springfield, IL 62701 yesterday")
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "123 Main St, Springfield, IL 62701", spans[0].Text)
+	})
+
+	t.Run("ordinal street names keep their number", func(t *testing.T) {
+		spans := finder.FindAddressSpans("Deliver to 450 W 31st St, New York, NY 10001.")
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "450 W 31st St, New York, NY 10001", spans[0].Text)
+	})
+
+	t.Run("sentence filler is not a street name", func(t *testing.T) {
+		spans := finder.FindAddressSpans("I saw 2 cats in the park on Main St, Springfield, IL 62701 today.")
+
+		assert.Empty(t, spans)
+	})
+
+	t.Run("filler before a real address is skipped", func(t *testing.T) {
+		spans := finder.FindAddressSpans("We had 2 boxes sent to 77 Elm Ave, Boise, ID 83702")
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "77 Elm Ave, Boise, ID 83702", spans[0].Text)
+	})
+
+	t.Run("state code and ZIP win over an earlier state name", func(t *testing.T) {
+		spans := finder.FindAddressSpans("Write to 1600 Pennsylvania Ave NW, Washington, DC 20500 for a reply.")
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "1600 Pennsylvania Ave NW, Washington, DC 20500", spans[0].Text)
+
+		spans = finder.FindAddressSpans("Ship to 12 Main St, Port Washington, NY 11050 today.")
+
+		require.Len(t, spans, 1)
+		assert.Equal(t, "12 Main St, Port Washington, NY 11050", spans[0].Text)
+	})
+
+	t.Run("prose without addresses", func(t *testing.T) {
+		spans := finder.FindAddressSpans("I called 3 times in the morning or in the evening.")
+
+		assert.Empty(t, spans)
+	})
+}
@@ -0,0 +1,117 @@
+// Package cache provides a result cache in front of the address parser.
+package cache
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+// Canonicalize folds the differences that do not change how an address is parsed:
+// letter case, runs of whitespace, and decorative punctuation such as periods and quotes.
+// Commas are kept (with surrounding spaces normalized) because they drive segmentation,
+// hyphens and '#' because they carry ZIP+4 and unit numbers, and slashes because they
+// carry fractional house numbers: "12 1/2 Main St" is not "12 1 2 Main St".
+func Canonicalize(raw string) string {
+	var b strings.Builder
+	b.Grow(len(raw))
+
+	for _, r := range strings.ToLower(raw) {
+		switch {
+		case r == ',' || isWordRune(r):
+			b.WriteRune(r)
+		default:
+			// Whitespace and all other punctuation become a word break.
+			b.WriteRune(' ')
+		}
+	}
+
+	folded := strings.Join(strings.Fields(b.String()), " ")
+	folded = strings.ReplaceAll(folded, " ,", ",")
+
+	parts := strings.Split(folded, ",")
+	kept := parts[:0]
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+
+	return strings.Join(kept, ", ")
+}
+
+// isWordRune reports whether Canonicalize keeps r inside a word.
+func isWordRune(r rune) bool {
+	return r == '-' || r == '#' || r == '/' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// words returns the byte range of each word Canonicalize keeps from s, so inputs with
+// the same canonical form have the same number of words, in the same order.
+func words(s string) [][2]int {
+	var ranges [][2]int
+	start := -1
+	for i, r := range s {
+		switch {
+		case isWordRune(r):
+			if start < 0 {
+				start = i
+			}
+		case start >= 0:
+			ranges = append(ranges, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		ranges = append(ranges, [2]int{start, len(s)})
+	}
+	return ranges
+}
+
+// relocateComponents moves spans recorded in from onto to, an input with the same
+// canonical form: each span covers the same words, as they are written in to.
+// Spans that hold no word, or inputs whose words do not line up, yield no span.
+func relocateComponents(from, to string, spans map[string]entity.TextSpan) map[string]entity.TextSpan {
+	fromWords, toWords := words(from), words(to)
+	if len(spans) == 0 || len(fromWords) != len(toWords) {
+		return nil
+	}
+
+	relocated := make(map[string]entity.TextSpan, len(spans))
+	for name, span := range spans {
+		start, end := byteOffset(from, span.Start), byteOffset(from, span.End)
+
+		first, last := -1, -1
+		for i, w := range fromWords {
+			if w[0] >= start && w[1] <= end {
+				if first < 0 {
+					first = i
+				}
+				last = i
+			}
+		}
+		if first < 0 {
+			continue
+		}
+
+		startByte, endByte := toWords[first][0], toWords[last][1]
+		relocated[name] = entity.TextSpan{
+			Text:  to[startByte:endByte],
+			Start: utf8.RuneCountInString(to[:startByte]),
+			End:   utf8.RuneCountInString(to[:endByte]),
+		}
+	}
+	return relocated
+}
+
+// byteOffset converts a character offset in s to a byte offset.
+func byteOffset(s string, chars int) int {
+	for i := range s {
+		if chars == 0 {
+			return i
+		}
+		chars--
+	}
+	return len(s)
+}
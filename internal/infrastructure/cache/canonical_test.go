@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"case and whitespace", "  123  MAIN st,  New York,NY 10001 ", "123 main st, new york, ny 10001"},
+		{"periods and quotes", `123 Main St., "New York", N.Y. 10001`, "123 main st, new york, n y 10001"},
+		{"space before comma", "123 Main St , New York , NY", "123 main st, new york, ny"},
+		{"empty segments collapse", "123 Main St,, New York", "123 main st, new york"},
+		{"keeps ZIP+4 and unit markers", "1 Elm St #4, Boise, ID 83702-1234", "1 elm st #4, boise, id 83702-1234"},
+		{"keeps fractional house numbers", "12 1/2 Main St", "12 1/2 main st"},
+		{"commas still separate", "123 Main St New York NY", "123 main st new york ny"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Canonicalize(tt.input))
+		})
+	}
+}
+
+func TestCanonicalize_DistinctAddressesDoNotCollide(t *testing.T) {
+	fractional := Canonicalize("12 1/2 Main St, Springfield, IL")
+
+	assert.NotEqual(t, fractional, Canonicalize("12 1 2 Main St, Springfield, IL"))
+	assert.NotEqual(t, fractional, Canonicalize("121 2 Main St, Springfield, IL"))
+	assert.NotEqual(t, fractional, Canonicalize("12 12 Main St, Springfield, IL"))
+}
+
+func TestRelocateComponents(t *testing.T) {
+	from := "123 Main Street, Springfield, Illinois 62701"
+	spans := map[string]entity.TextSpan{
+		entity.ComponentStreetAddress: {Text: "123 Main Street", Start: 0, End: 15},
+		entity.ComponentState:         {Text: "Illinois", Start: 30, End: 38},
+	}
+
+	t.Run("spans cover the same words in the new input", func(t *testing.T) {
+		relocated := relocateComponents(from, "123  MAIN STREET., springfield, ILLINOIS 62701", spans)
+
+		assert.Equal(t, map[string]entity.TextSpan{
+			entity.ComponentStreetAddress: {Text: "123  MAIN STREET", Start: 0, End: 16},
+			entity.ComponentState:         {Text: "ILLINOIS", Start: 32, End: 40},
+		}, relocated)
+	})
+
+	t.Run("inputs whose words do not line up get no spans", func(t *testing.T) {
+		assert.Empty(t, relocateComponents(from, "123 Main Street Springfield", spans))
+	})
+}
@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
+)
+
+// keyVersion is bumped when the cached entry layout or the canonical form changes.
+const keyVersion = "v3"
+
+// versionedPrefix namespaces keys by entry layout and by parser and dataset version,
+// so upgrading either starts from a cold cache instead of serving stale results.
+func versionedPrefix(namespace string) string {
+	return namespace + ":" + keyVersion + ":" + address_parser.ParserVersion + ":" + reference.DatasetVersion + ":"
+}
+
+// Parser is the repository being cached (a ValidateAddressRepository).
+type Parser interface {
+	ParseAddress(ctx context.Context, rawAddress string) (*entity.Address, []*entity.Address, error)
+}
+
+// Config controls how long parse results are kept.
+type Config struct {
+	// TTL applies to successful parses; zero keeps entries until the store evicts them.
+	TTL time.Duration
+	// NegativeTTL applies to parsing failures; zero disables negative caching.
+	NegativeTTL time.Duration
+}
+
+// Repository implements ValidateAddressRepository by serving parse results from a
+// Store and falling back to the inner parser on a miss.
+type Repository struct {
+	inner  Parser
+	store  Store
+	config Config
+	prefix string
+}
+
+type cachedResult struct {
+	// Raw is the input the entry was parsed from; component spans point into it.
+	Raw        string                     `json:"raw,omitempty"`
+	Address    *entity.Address            `json:"address,omitempty"`
+	Candidates []*entity.Address          `json:"candidates,omitempty"`
+	Error      *domainerrors.ParsingError `json:"error,omitempty"`
+}
+
+// NewRepository creates a Repository caching inner's results in store.
+func NewRepository(inner Parser, store Store, config Config) *Repository {
+	return &Repository{
+		inner:  inner,
+		store:  store,
+		config: config,
+		prefix: versionedPrefix("addr"),
+	}
+}
+
+// Key returns the cache key for rawAddress. Keys include the parser and dataset
+// versions, so upgrading either starts from a cold cache instead of serving stale results.
+func (r *Repository) Key(rawAddress string) string {
+	sum := sha256.Sum256([]byte(Canonicalize(rawAddress)))
+	return r.prefix + hex.EncodeToString(sum[:])
+}
+
+// ParseAddress returns the cached result for rawAddress, parsing and caching it on a miss.
+// A request that carries a decision trace always parses, so the parser's decisions are recorded.
+func (r *Repository) ParseAddress(ctx context.Context, rawAddress string) (*entity.Address, []*entity.Address, error) {
+	key := r.Key(rawAddress)
+	meta := entity.ParseMetadataFromContext(ctx)
+	explain := entity.DecisionTraceFromContext(ctx) != nil
+
+	if cached, ok := r.lookup(ctx, key); ok && !explain {
+		if meta != nil {
+			meta.Cache = entity.CacheHit
+		}
+		if cached.Error != nil {
+			return nil, nil, cached.Error
+		}
+
+		refreshRawFields(cached.Raw, rawAddress, cached.Address)
+		for _, cand := range cached.Candidates {
+			refreshRawFields(cached.Raw, rawAddress, cand)
+		}
+		return cached.Address, cached.Candidates, nil
+	}
+
+	if meta != nil {
+		meta.Cache = entity.CacheMiss
+	}
+
+	addr, candidates, err := r.inner.ParseAddress(ctx, rawAddress)
+	r.save(ctx, key, rawAddress, addr, candidates, err)
+
+	return addr, candidates, err
+}
+
+// lookup treats unreadable entries and store failures as misses; the cache must never fail a request.
+func (r *Repository) lookup(ctx context.Context, key string) (*cachedResult, bool) {
+	data, found, err := r.store.Get(ctx, key)
+	if err != nil || !found {
+		return nil, false
+	}
+
+	var cached cachedResult
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, false
+	}
+	if cached.Address == nil && cached.Error == nil {
+		return nil, false
+	}
+
+	return &cached, true
+}
+
+func (r *Repository) save(
+	ctx context.Context,
+	key, rawAddress string,
+	addr *entity.Address,
+	candidates []*entity.Address,
+	err error,
+) {
+	entry := cachedResult{Raw: rawAddress, Address: addr, Candidates: candidates}
+	ttl := r.config.TTL
+
+	if err != nil {
+		// Only parsing failures are a property of the input; anything else may succeed on retry.
+		var parsingErr *domainerrors.ParsingError
+		if !errors.As(err, &parsingErr) || r.config.NegativeTTL <= 0 {
+			return
+		}
+		entry = cachedResult{Error: parsingErr}
+		ttl = r.config.NegativeTTL
+	}
+
+	data, marshalErr := json.Marshal(entry)
+	if marshalErr != nil {
+		return
+	}
+	_ = r.store.Set(ctx, key, data, ttl)
+}
+
+// refreshRawFields recomputes the fields that depend on the exact input text, since
+// a hit may have been stored for a differently cased or punctuated variant (cachedRaw).
+func refreshRawFields(cachedRaw, rawAddress string, addr *entity.Address) {
+	if addr == nil {
+		return
+	}
+	addr.Components = relocateComponents(cachedRaw, rawAddress, addr.Components)
+	addr.Corrections = address_parser.TrackCorrections(rawAddress, addr)
+}
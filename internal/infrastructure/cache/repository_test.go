@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
+)
+
+type countingParser struct {
+	calls int
+	err   error
+}
+
+func (p *countingParser) ParseAddress(_ context.Context, raw string) (*entity.Address, []*entity.Address, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, nil, p.err
+	}
+	return &entity.Address{
+		StreetAddress: "123 Main St",
+		City:          "New York",
+		State:         "NY",
+		PostalCode:    "10001",
+		Evidence:      map[string]entity.Evidence{entity.ComponentState: entity.EvidenceReference},
+		// Where each component is in "123 Main St, New York, NY 10001".
+		Components: map[string]entity.TextSpan{
+			entity.ComponentStreetAddress: {Text: "123 Main St", Start: 0, End: 11},
+			entity.ComponentCity:          {Text: "New York", Start: 13, End: 21},
+			entity.ComponentState:         {Text: "NY", Start: 23, End: 25},
+			entity.ComponentPostalCode:    {Text: "10001", Start: 26, End: 31},
+		},
+	}, nil, nil
+}
+
+func TestRepository_ParseAddress(t *testing.T) {
+	t.Run("second equivalent request is a hit", func(t *testing.T) {
+		inner := &countingParser{}
+		repo := NewRepository(inner, NewMemoryStore(), Config{TTL: time.Hour})
+
+		meta := &entity.ParseMetadata{}
+		ctx := entity.ContextWithParseMetadata(context.Background(), meta)
+		_, _, err := repo.ParseAddress(ctx, "123 Main St, New York, NY 10001")
+		require.NoError(t, err)
+		assert.Equal(t, entity.CacheMiss, meta.Cache)
+
+		meta = &entity.ParseMetadata{}
+		ctx = entity.ContextWithParseMetadata(context.Background(), meta)
+		addr, _, err := repo.ParseAddress(ctx, "123 main st.,  new york, ny 10001")
+		require.NoError(t, err)
+		assert.Equal(t, entity.CacheHit, meta.Cache)
+		assert.Equal(t, 1, inner.calls)
+
+		assert.Equal(t, "New York", addr.City)
+		assert.Equal(t, entity.EvidenceReference, addr.Evidence[entity.ComponentState])
+		// Offsets and corrections describe this request's text, not the cached one's.
+		assert.Equal(t, "new york", addr.Components[entity.ComponentCity].Text)
+		assert.Equal(t, 15, addr.Components[entity.ComponentCity].Start)
+		assert.Equal(t, entity.TextSpan{Text: "ny", Start: 25, End: 27}, addr.Components[entity.ComponentState])
+		require.Len(t, addr.Corrections, 3)
+		assert.Equal(t, entity.CorrectionWhitespace, addr.Corrections[0].Type)
+		assert.Equal(t, entity.CorrectionCapitalization, addr.Corrections[2].Type)
+		assert.Equal(t, "new york", addr.Corrections[2].Original)
+	})
+
+	t.Run("explained requests bypass the cached result", func(t *testing.T) {
+		inner := &countingParser{}
+		repo := NewRepository(inner, NewMemoryStore(), Config{TTL: time.Hour})
+
+		_, _, err := repo.ParseAddress(context.Background(), "123 Main St, New York, NY 10001")
+		require.NoError(t, err)
+
+		meta := &entity.ParseMetadata{}
+		ctx := entity.ContextWithParseMetadata(context.Background(), meta)
+		ctx = entity.ContextWithDecisionTrace(ctx, &entity.DecisionTrace{})
+		_, _, err = repo.ParseAddress(ctx, "123 Main St, New York, NY 10001")
+		require.NoError(t, err)
+		assert.Equal(t, entity.CacheMiss, meta.Cache)
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("parsing failures are cached for the negative TTL", func(t *testing.T) {
+		inner := &countingParser{err: &domainerrors.ParsingError{Field: "address", Reason: "no components"}}
+		repo := NewRepository(inner, NewMemoryStore(), Config{TTL: time.Hour, NegativeTTL: time.Minute})
+
+		for i := 0; i < 2; i++ {
+			_, _, err := repo.ParseAddress(context.Background(), "gibberish")
+			var pe *domainerrors.ParsingError
+			require.ErrorAs(t, err, &pe)
+			assert.Equal(t, "no components", pe.Reason)
+		}
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("failures are not cached without a negative TTL", func(t *testing.T) {
+		inner := &countingParser{err: &domainerrors.ParsingError{Reason: "no components"}}
+		repo := NewRepository(inner, NewMemoryStore(), Config{TTL: time.Hour})
+
+		_, _, _ = repo.ParseAddress(context.Background(), "gibberish")
+		_, _, _ = repo.ParseAddress(context.Background(), "gibberish")
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("unexpected errors are never cached", func(t *testing.T) {
+		inner := &countingParser{err: errors.New("parser crashed")}
+		repo := NewRepository(inner, NewMemoryStore(), Config{TTL: time.Hour, NegativeTTL: time.Minute})
+
+		_, _, _ = repo.ParseAddress(context.Background(), "123 Main St")
+		_, _, _ = repo.ParseAddress(context.Background(), "123 Main St")
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("entries expire after the TTL", func(t *testing.T) {
+		now := time.Now()
+		store := NewMemoryStore()
+		store.now = func() time.Time { return now }
+		inner := &countingParser{}
+		repo := NewRepository(inner, store, Config{TTL: time.Minute})
+
+		_, _, _ = repo.ParseAddress(context.Background(), "123 Main St, New York, NY")
+		now = now.Add(2 * time.Minute)
+		_, _, _ = repo.ParseAddress(context.Background(), "123 Main St, New York, NY")
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("keys are namespaced by parser and dataset version", func(t *testing.T) {
+		repo := NewRepository(&countingParser{}, NewMemoryStore(), Config{})
+
+		key := repo.Key("123 Main St")
+		assert.True(t, strings.HasPrefix(key, "addr:"+keyVersion+":"+address_parser.ParserVersion+":"+reference.DatasetVersion+":"))
+		assert.Equal(t, key, repo.Key("123 MAIN ST."))
+		assert.NotEqual(t, key, repo.Key("124 Main St"))
+	})
+}
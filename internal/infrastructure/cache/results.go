@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+)
+
+// resultKeyPrefix namespaces validation job results away from parse results. Like parse
+// results, they are versioned: a result is only served by the parser and dataset that produced it.
+var resultKeyPrefix = versionedPrefix("job")
+
+// ResultStore implements ValidationResultStore on a Store shared by the API and the worker.
+type ResultStore struct {
+	store Store
+	ttl   time.Duration
+}
+
+// NewResultStore creates a ResultStore keeping results for ttl (zero keeps them until evicted).
+func NewResultStore(store Store, ttl time.Duration) *ResultStore {
+	return &ResultStore{store: store, ttl: ttl}
+}
+
+// SaveResult stores the result of job id.
+func (s *ResultStore) SaveResult(ctx context.Context, id string, result *dto.ValidationJobResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return s.store.Set(ctx, s.Key(id), data, s.ttl)
+}
+
+// Key returns the store key of job id's result.
+func (s *ResultStore) Key(id string) string {
+	return resultKeyPrefix + id
+}
+
+// LoadResult returns the result of job id if the worker has stored one.
+func (s *ResultStore) LoadResult(ctx context.Context, id string) (*dto.ValidationJobResult, bool, error) {
+	data, found, err := s.store.Get(ctx, s.Key(id))
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	var result dto.ValidationJobResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false, err
+	}
+	return &result, true, nil
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
+)
+
+func TestResultStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := NewResultStore(NewMemoryStore(), 0)
+
+	_, found, err := store.LoadResult(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.SaveResult(ctx, "ok", &dto.ValidationJobResult{
+		Response: &dto.ValidateResponse{Success: true, Status: dto.StatusValid},
+	}))
+	require.NoError(t, store.SaveResult(ctx, "failed", &dto.ValidationJobResult{
+		Error: dto.NewValidationJobError(&domainerrors.ParsingError{Field: "address", Reason: "invalid state code: XX", Value: "XX"}),
+	}))
+
+	ok, found, err := store.LoadResult(ctx, "ok")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, dto.StatusValid, ok.Response.Status)
+
+	failed, found, err := store.LoadResult(ctx, "failed")
+	require.NoError(t, err)
+	require.True(t, found)
+	var pe *domainerrors.ParsingError
+	require.ErrorAs(t, failed.Error.Err(), &pe)
+	assert.Equal(t, "XX", pe.Value)
+}
+
+func TestResultStore_Key(t *testing.T) {
+	store := NewResultStore(NewMemoryStore(), 0)
+
+	assert.Equal(t, "job:"+keyVersion+":"+address_parser.ParserVersion+":"+reference.DatasetVersion+":abc", store.Key("abc"))
+}
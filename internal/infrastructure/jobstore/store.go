@@ -0,0 +1,380 @@
+// Package jobstore persists batch validation jobs in GoFr's SQL datasource.
+// The schema is created by the migrations package.
+package jobstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+// ErrNotReady is returned when the store is used before the database is bound.
+var ErrNotReady = errors.New("jobstore: database not bound yet")
+
+// insertBatchSize keeps multi-row inserts well under SQLite's bound-parameter limit.
+const insertBatchSize = 200
+
+// DB is the subset of GoFr's SQL datasource (container.SQL) the store needs.
+type DB interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Tx is a transaction on the datasource; GoFr's *sql.Tx satisfies it.
+type Tx interface {
+	DB
+	Commit() error
+	Rollback() error
+}
+
+// Store implements JobRepository on SQL. GoFr only exposes its datasources once the
+// app starts, so the database is bound late.
+type Store struct {
+	mu    sync.RWMutex
+	db    DB
+	begin func() (Tx, error)
+	now   func() time.Time
+}
+
+// NewStore creates a Store with no database bound.
+func NewStore() *Store {
+	return &Store{now: time.Now}
+}
+
+// SetDB binds the database, typically from an app.OnStart hook. begin starts a
+// transaction on it, for writes that must be stored together.
+func (s *Store) SetDB(db DB, begin func() (Tx, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.db = db
+	s.begin = begin
+}
+
+// Ping checks that the database is bound and answers a query.
+func (s *Store) Ping(ctx context.Context) error {
+	db, err := s.getDB()
+	if err != nil {
+		return err
+	}
+
+	var one int
+	return db.QueryRowContext(ctx, "SELECT 1").Scan(&one)
+}
+
+func (s *Store) getDB() (DB, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.db == nil {
+		return nil, ErrNotReady
+	}
+	return s.db, nil
+}
+
+// inTx runs fn in a transaction, committing only if it succeeds.
+func (s *Store) inTx(fn func(db DB) error) error {
+	s.mu.RLock()
+	begin := s.begin
+	s.mu.RUnlock()
+	if begin == nil {
+		return ErrNotReady
+	}
+
+	tx, err := begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateJob stores the job and its addresses in one transaction, so a failure part
+// way leaves no items without their job, and a worker never claims a job whose
+// addresses are not all stored.
+func (s *Store) CreateJob(ctx context.Context, job *entity.Job, options *dto.ValidateRequest, addresses []string) error {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	return s.inTx(func(db DB) error {
+		for start := 0; start < len(addresses); start += insertBatchSize {
+			end := min(start+insertBatchSize, len(addresses))
+
+			rows := make([]string, 0, end-start)
+			args := make([]any, 0, (end-start)*4)
+			for i := start; i < end; i++ {
+				rows = append(rows, "(?, ?, ?, ?)")
+				args = append(args, job.ID, i, addresses[i], string(entity.JobItemPending))
+			}
+
+			query := "INSERT INTO validation_job_items (job_id, position, address, status) VALUES " + strings.Join(rows, ", ")
+			if _, err := db.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+
+		callbackURL, callbackEvents := encodeCallback(job.Callback)
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO validation_jobs (id, status, error, options, tenant, callback_url, callback_events, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			job.ID, string(job.Status), job.Error, string(optionsJSON), job.Tenant, callbackURL, callbackEvents,
+			job.CreatedAt.UnixMilli(), job.UpdatedAt.UnixMilli())
+		return err
+	})
+}
+
+// GetJob returns the job with its progress counted from its items.
+func (s *Store) GetJob(ctx context.Context, id string) (*entity.Job, bool, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	row := db.QueryRowContext(ctx, `
+		SELECT j.id, j.status, j.error, j.tenant, j.callback_url, j.callback_events, j.created_at, j.updated_at,
+			COUNT(i.position),
+			COALESCE(SUM(CASE WHEN i.status <> 'pending' THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN i.status = 'succeeded' THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN i.status = 'failed' THEN 1 ELSE 0 END), 0)
+		FROM validation_jobs j
+		LEFT JOIN validation_job_items i ON i.job_id = j.id
+		WHERE j.id = ?
+		GROUP BY j.id, j.status, j.error, j.tenant, j.callback_url, j.callback_events, j.created_at, j.updated_at`, id)
+
+	var (
+		job                         entity.Job
+		status                      string
+		callbackURL, callbackEvents string
+		createdAt, updatedAt        int64
+	)
+	err = row.Scan(&job.ID, &status, &job.Error, &job.Tenant, &callbackURL, &callbackEvents, &createdAt, &updatedAt,
+		&job.Progress.Total, &job.Progress.Processed, &job.Progress.Succeeded, &job.Progress.Failed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	job.Status = entity.JobStatus(status)
+	job.Callback = decodeCallback(callbackURL, callbackEvents)
+	job.CreatedAt = time.UnixMilli(createdAt).UTC()
+	job.UpdatedAt = time.UnixMilli(updatedAt).UTC()
+	return &job, true, nil
+}
+
+// ListJobResults returns items in submission order with their stored results.
+func (s *Store) ListJobResults(ctx context.Context, id string, offset, limit int) ([]*usecase.JobItemResult, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx,
+		"SELECT position, address, status, result FROM validation_job_items WHERE job_id = ? ORDER BY position LIMIT ? OFFSET ?",
+		id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*usecase.JobItemResult
+	for rows.Next() {
+		var (
+			item       usecase.JobItemResult
+			status     string
+			resultJSON sql.NullString
+		)
+		if err := rows.Scan(&item.Item.Index, &item.Item.Address, &status, &resultJSON); err != nil {
+			return nil, err
+		}
+		item.Item.Status = entity.JobItemStatus(status)
+
+		if resultJSON.Valid {
+			item.Result = &dto.ValidationJobResult{}
+			if err := json.Unmarshal([]byte(resultJSON.String), item.Result); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, &item)
+	}
+
+	return results, rows.Err()
+}
+
+// ClaimJob atomically moves the oldest queued job whose next attempt is due to running.
+// A job backing off after a stopped run is skipped, so it cannot hold up the jobs
+// queued after it.
+func (s *Store) ClaimJob(ctx context.Context) (*entity.Job, *dto.ValidateRequest, bool, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	now := s.now().UTC()
+	row := db.QueryRowContext(ctx, `
+		UPDATE validation_jobs SET status = ?, updated_at = ?
+		WHERE id = (
+			SELECT id FROM validation_jobs WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY created_at, id LIMIT 1)
+		RETURNING id, options, tenant, callback_url, callback_events, attempts, created_at`,
+		string(entity.JobRunning), now.UnixMilli(), string(entity.JobQueued), now.UnixMilli())
+
+	var (
+		job                         = entity.Job{Status: entity.JobRunning, UpdatedAt: now}
+		optionsJSON                 string
+		callbackURL, callbackEvents string
+		createdAt                   int64
+	)
+	err = row.Scan(&job.ID, &optionsJSON, &job.Tenant, &callbackURL, &callbackEvents, &job.Attempts, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, false, nil
+	}
+	if err != nil {
+		return nil, nil, false, err
+	}
+	job.Callback = decodeCallback(callbackURL, callbackEvents)
+	job.CreatedAt = time.UnixMilli(createdAt).UTC()
+
+	var options dto.ValidateRequest
+	if err := json.Unmarshal([]byte(optionsJSON), &options); err != nil {
+		return nil, nil, false, err
+	}
+
+	return &job, &options, true, nil
+}
+
+// PendingJobItems returns the items of job id that have no result yet, in order.
+func (s *Store) PendingJobItems(ctx context.Context, id string) ([]entity.JobItem, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx,
+		"SELECT position, address FROM validation_job_items WHERE job_id = ? AND status = ? ORDER BY position",
+		id, string(entity.JobItemPending))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []entity.JobItem
+	for rows.Next() {
+		item := entity.JobItem{Status: entity.JobItemPending}
+		if err := rows.Scan(&item.Index, &item.Address); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+// SaveJobItemResult stores the outcome of one item and, in the same transaction, the
+// webhook reporting it when notify is not nil.
+func (s *Store) SaveJobItemResult(ctx context.Context, id string, index int, result *dto.ValidationJobResult, notify *entity.WebhookDelivery) error {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	status := entity.JobItemSucceeded
+	if result.Error != nil {
+		status = entity.JobItemFailed
+	}
+
+	return s.inTx(func(db DB) error {
+		_, err := db.ExecContext(ctx,
+			"UPDATE validation_job_items SET status = ?, result = ? WHERE job_id = ? AND position = ?",
+			string(status), string(resultJSON), id, index)
+		if err != nil {
+			return err
+		}
+		return insertDelivery(ctx, db, notify)
+	})
+}
+
+// FinishJob records the job's final status, where reason explains a failure, and in the
+// same transaction the webhook reporting it when notify is not nil.
+func (s *Store) FinishJob(ctx context.Context, id string, status entity.JobStatus, reason string, notify *entity.WebhookDelivery) error {
+	return s.inTx(func(db DB) error {
+		_, err := db.ExecContext(ctx,
+			"UPDATE validation_jobs SET status = ?, error = ?, updated_at = ? WHERE id = ?",
+			string(status), reason, s.now().UTC().UnixMilli(), id)
+		if err != nil {
+			return err
+		}
+		return insertDelivery(ctx, db, notify)
+	})
+}
+
+// RequeueJob returns job id to the queue if it is running, with attempts recorded and
+// no claim before nextAttemptAt; its pending items are picked up again.
+func (s *Store) RequeueJob(ctx context.Context, id string, attempts int, nextAttemptAt time.Time) error {
+	db, err := s.getDB()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx,
+		"UPDATE validation_jobs SET status = ?, attempts = ?, next_attempt_at = ?, updated_at = ? WHERE id = ? AND status = ?",
+		string(entity.JobQueued), attempts, nextAttemptAt.UTC().UnixMilli(), s.now().UTC().UnixMilli(), id, string(entity.JobRunning))
+	return err
+}
+
+// RequeueRunningJobs returns running jobs to the queue; their pending items are picked up again.
+func (s *Store) RequeueRunningJobs(ctx context.Context) (int, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := db.ExecContext(ctx,
+		"UPDATE validation_jobs SET status = ?, updated_at = ? WHERE status = ?",
+		string(entity.JobQueued), s.now().UTC().UnixMilli(), string(entity.JobRunning))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func encodeCallback(callback *entity.JobCallback) (url, events string) {
+	if callback == nil {
+		return "", ""
+	}
+
+	names := make([]string, len(callback.Events))
+	for i, event := range callback.Events {
+		names[i] = string(event)
+	}
+	return callback.URL, strings.Join(names, ",")
+}
+
+func decodeCallback(url, events string) *entity.JobCallback {
+	if url == "" {
+		return nil
+	}
+
+	callback := &entity.JobCallback{URL: url}
+	for _, name := range strings.Split(events, ",") {
+		if name != "" {
+			callback.Events = append(callback.Events, entity.WebhookEvent(name))
+		}
+	}
+	return callback
+}
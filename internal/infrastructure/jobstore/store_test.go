@@ -0,0 +1,195 @@
+package jobstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+var fixedNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func newTestStore(t *testing.T) (*Store, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	store := NewStore()
+	store.now = func() time.Time { return fixedNow }
+	store.SetDB(db, func() (Tx, error) { return db.Begin() })
+	return store, mock
+}
+
+func TestStore_NotReady(t *testing.T) {
+	_, _, err := NewStore().GetJob(context.Background(), "abc")
+	assert.ErrorIs(t, err, ErrNotReady)
+}
+
+func TestStore_Ping(t *testing.T) {
+	assert.ErrorIs(t, NewStore().Ping(context.Background()), ErrNotReady)
+
+	store, mock := newTestStore(t)
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+	require.NoError(t, store.Ping(context.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStore_CreateJob(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	addresses := make([]string, insertBatchSize+1)
+	for i := range addresses {
+		addresses[i] = "123 Main St"
+	}
+	job := &entity.Job{
+		ID:        "abc",
+		Status:    entity.JobQueued,
+		Tenant:    "acme",
+		Callback:  &entity.JobCallback{URL: "https://example.com/hooks", Events: []entity.WebhookEvent{entity.EventJobCompleted, entity.EventItemCompleted}},
+		CreatedAt: fixedNow,
+		UpdatedAt: fixedNow,
+	}
+
+	// Items go in batches, and the job row is written last so it cannot be claimed early,
+	// all in one transaction.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO validation_job_items").WillReturnResult(sqlmock.NewResult(0, insertBatchSize))
+	mock.ExpectExec("INSERT INTO validation_job_items").
+		WithArgs("abc", insertBatchSize, "123 Main St", "pending").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO validation_jobs").
+		WithArgs("abc", "queued", "", `{"address":"","strictness":"lenient"}`, "acme",
+			"https://example.com/hooks", "job.completed,item.completed", fixedNow.UnixMilli(), fixedNow.UnixMilli()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := store.CreateJob(context.Background(), job, &dto.ValidateRequest{Strictness: "lenient"}, addresses)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStore_CreateJob_RollsBackOnFailure(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	addresses := make([]string, insertBatchSize+1)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO validation_job_items").WillReturnResult(sqlmock.NewResult(0, insertBatchSize))
+	mock.ExpectExec("INSERT INTO validation_job_items").WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+
+	err := store.CreateJob(context.Background(), &entity.Job{ID: "abc", Status: entity.JobQueued}, &dto.ValidateRequest{}, addresses)
+
+	require.EqualError(t, err, "disk full")
+	assert.NoError(t, mock.ExpectationsWereMet(), "the first batch of items is rolled back")
+}
+
+func TestStore_GetJob(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectQuery("FROM validation_jobs j").WithArgs("abc").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "status", "error", "tenant", "callback_url", "callback_events",
+			"created_at", "updated_at", "total", "processed", "succeeded", "failed"}).
+			AddRow("abc", "running", "", "acme", "https://example.com/hooks", "job.completed",
+				fixedNow.UnixMilli(), fixedNow.UnixMilli(), 3, 2, 1, 1))
+	mock.ExpectQuery("FROM validation_jobs j").WithArgs("missing").WillReturnError(sql.ErrNoRows)
+
+	job, found, err := store.GetJob(context.Background(), "abc")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, entity.JobRunning, job.Status)
+	assert.Equal(t, entity.JobProgress{Total: 3, Processed: 2, Succeeded: 1, Failed: 1}, job.Progress)
+	assert.Equal(t, fixedNow, job.CreatedAt)
+	assert.Equal(t, "acme", job.Tenant)
+	assert.Equal(t, &entity.JobCallback{URL: "https://example.com/hooks", Events: []entity.WebhookEvent{entity.EventJobCompleted}}, job.Callback)
+
+	_, found, err = store.GetJob(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_ClaimJob(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectQuery("UPDATE validation_jobs SET status").
+		WithArgs("running", fixedNow.UnixMilli(), "queued", fixedNow.UnixMilli()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "options", "tenant", "callback_url", "callback_events", "attempts", "created_at"}).
+			AddRow("abc", `{"address":"","mode":"postal"}`, "default", "", "", 2, fixedNow.UnixMilli()))
+	mock.ExpectQuery("UPDATE validation_jobs SET status").WillReturnError(sql.ErrNoRows)
+
+	job, options, claimed, err := store.ClaimJob(context.Background())
+	require.NoError(t, err)
+	require.True(t, claimed)
+	assert.Equal(t, "abc", job.ID)
+	assert.Equal(t, entity.JobRunning, job.Status)
+	assert.Equal(t, "postal", options.Mode)
+	assert.Equal(t, 2, job.Attempts)
+	assert.Equal(t, entity.DefaultTenant, job.Tenant)
+	assert.Nil(t, job.Callback)
+
+	_, _, claimed, err = store.ClaimJob(context.Background())
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func TestStore_SaveAndListResults(t *testing.T) {
+	store, mock := newTestStore(t)
+	failed := &dto.ValidationJobResult{
+		Error: dto.NewValidationJobError(&domainerrors.ParsingError{Field: "address", Reason: "could not parse"}),
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE validation_job_items SET status").
+		WithArgs("failed", sqlmock.AnyArg(), "abc", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT position, address, status, result FROM validation_job_items").
+		WithArgs("abc", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"position", "address", "status", "result"}).
+			AddRow(0, "a", "pending", nil).
+			AddRow(1, "b", "failed", `{"error":{"kind":"parsing","field":"address","reason":"could not parse"}}`))
+
+	require.NoError(t, store.SaveJobItemResult(context.Background(), "abc", 1, failed, nil))
+
+	results, err := store.ListJobResults(context.Background(), "abc", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Nil(t, results[0].Result)
+	assert.Equal(t, entity.JobItemPending, results[0].Item.Status)
+	assert.Equal(t, entity.JobItemFailed, results[1].Item.Status)
+	assert.Equal(t, failed, results[1].Result)
+}
+
+func TestStore_RequeueRunningJobs(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectExec("UPDATE validation_jobs SET status").
+		WithArgs("queued", fixedNow.UnixMilli(), "running").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	n, err := store.RequeueRunningJobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestStore_RequeueJob(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectExec("UPDATE validation_jobs SET status").
+		WithArgs("queued", 3, fixedNow.Add(time.Minute).UnixMilli(), fixedNow.UnixMilli(), "abc", "running").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.RequeueJob(context.Background(), "abc", 3, fixedNow.Add(time.Minute)))
+}
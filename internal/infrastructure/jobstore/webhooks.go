@@ -0,0 +1,176 @@
+package jobstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+const deliveryColumns = "id, job_id, tenant, url, event, payload, status, attempts, last_error, next_attempt_at, created_at, updated_at, version"
+
+// insertDelivery stores a new webhook delivery on db, typically the transaction that
+// stores what the webhook reports. A nil delivery stores nothing.
+func insertDelivery(ctx context.Context, db DB, delivery *entity.WebhookDelivery) error {
+	if delivery == nil {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx,
+		"INSERT INTO webhook_deliveries ("+deliveryColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		delivery.ID, delivery.JobID, delivery.Tenant, delivery.URL, string(delivery.Event), string(delivery.Payload),
+		string(delivery.Status), delivery.Attempts, delivery.LastError,
+		delivery.NextAttemptAt.UnixMilli(), delivery.CreatedAt.UnixMilli(), delivery.UpdatedAt.UnixMilli(), delivery.Version)
+	return err
+}
+
+// GetDelivery returns one webhook delivery.
+func (s *Store) GetDelivery(ctx context.Context, id string) (*entity.WebhookDelivery, bool, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	row := db.QueryRowContext(ctx, "SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE id = ?", id)
+
+	delivery, err := scanDelivery(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return delivery, true, nil
+}
+
+// ListDeliveries returns a job's webhook deliveries, oldest first.
+func (s *Store) ListDeliveries(ctx context.Context, jobID string) ([]*entity.WebhookDelivery, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx,
+		"SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE job_id = ? ORDER BY created_at, id", jobID)
+	if err != nil {
+		return nil, err
+	}
+	return scanDeliveries(rows)
+}
+
+// ClaimDueDeliveries claims pending deliveries whose next attempt is due, oldest due
+// first, until the given time. Claiming and selecting are one statement, so concurrent
+// deliverers never claim the same delivery while its claim holds.
+func (s *Store) ClaimDueDeliveries(ctx context.Context, now, until time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		UPDATE webhook_deliveries SET claimed_until = ?, version = version + 1
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ? AND claimed_until <= ?
+			ORDER BY next_attempt_at, id LIMIT ?)
+		RETURNING `+deliveryColumns,
+		until.UnixMilli(), string(entity.DeliveryPending), now.UnixMilli(), now.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := scanDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+	// RETURNING does not keep the subquery's order.
+	sort.Slice(deliveries, func(i, j int) bool {
+		a, b := deliveries[i], deliveries[j]
+		if !a.NextAttemptAt.Equal(b.NextAttemptAt) {
+			return a.NextAttemptAt.Before(b.NextAttemptAt)
+		}
+		return a.ID < b.ID
+	})
+	return deliveries, nil
+}
+
+// UpdateDelivery stores the outcome of an attempt and releases the claim, unless the
+// delivery changed since it was read. It reports whether the outcome was stored.
+func (s *Store) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (bool, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := db.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, updated_at = ?, claimed_until = 0, version = version + 1
+		WHERE id = ? AND version = ?`,
+		string(delivery.Status), delivery.Attempts, delivery.LastError,
+		delivery.NextAttemptAt.UnixMilli(), delivery.UpdatedAt.UnixMilli(), delivery.ID, delivery.Version)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ResetDelivery makes a delivery pending and due at now with a fresh set of attempts,
+// whatever its state. It releases any claim, so an attempt in flight cannot store its
+// outcome over the reset.
+func (s *Store) ResetDelivery(ctx context.Context, id string, now time.Time) error {
+	db, err := s.getDB()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = ?, attempts = 0, last_error = '', next_attempt_at = ?, updated_at = ?, claimed_until = 0, version = version + 1
+		WHERE id = ?`,
+		string(entity.DeliveryPending), now.UnixMilli(), now.UnixMilli(), id)
+	return err
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDelivery(row scanner) (*entity.WebhookDelivery, error) {
+	var (
+		delivery                            entity.WebhookDelivery
+		event, payload, status              string
+		nextAttemptAt, createdAt, updatedAt int64
+	)
+	err := row.Scan(&delivery.ID, &delivery.JobID, &delivery.Tenant, &delivery.URL, &event, &payload,
+		&status, &delivery.Attempts, &delivery.LastError, &nextAttemptAt, &createdAt, &updatedAt, &delivery.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Event = entity.WebhookEvent(event)
+	delivery.Payload = []byte(payload)
+	delivery.Status = entity.DeliveryStatus(status)
+	delivery.NextAttemptAt = time.UnixMilli(nextAttemptAt).UTC()
+	delivery.CreatedAt = time.UnixMilli(createdAt).UTC()
+	delivery.UpdatedAt = time.UnixMilli(updatedAt).UTC()
+	return &delivery, nil
+}
+
+func scanDeliveries(rows *sql.Rows) ([]*entity.WebhookDelivery, error) {
+	defer rows.Close()
+
+	var deliveries []*entity.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
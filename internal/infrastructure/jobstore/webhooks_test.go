@@ -0,0 +1,131 @@
+package jobstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+var deliveryRowColumns = []string{
+	"id", "job_id", "tenant", "url", "event", "payload", "status",
+	"attempts", "last_error", "next_attempt_at", "created_at", "updated_at", "version",
+}
+
+func TestStore_FinishJobStoresWebhook(t *testing.T) {
+	store, mock := newTestStore(t)
+	notify := &entity.WebhookDelivery{
+		ID: "d1", JobID: "abc", Tenant: "acme", URL: "https://example.com/hooks",
+		Event: entity.EventJobCompleted, Payload: []byte(`{"event":"job.completed"}`), Status: entity.DeliveryPending,
+		NextAttemptAt: fixedNow, CreatedAt: fixedNow, UpdatedAt: fixedNow,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE validation_jobs SET status").
+		WithArgs("done", "", fixedNow.UnixMilli(), "abc").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO webhook_deliveries").
+		WithArgs("d1", "abc", "acme", "https://example.com/hooks", "job.completed", `{"event":"job.completed"}`,
+			"pending", 0, "", fixedNow.UnixMilli(), fixedNow.UnixMilli(), fixedNow.UnixMilli(), 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, store.FinishJob(context.Background(), "abc", entity.JobDone, "", notify))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStore_SaveJobItemResultRollsBackWithoutWebhook(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE validation_job_items SET status").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO webhook_deliveries").WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+
+	err := store.SaveJobItemResult(context.Background(), "abc", 0, &dto.ValidationJobResult{},
+		&entity.WebhookDelivery{ID: "d1", Event: entity.EventItemCompleted})
+	require.EqualError(t, err, "disk full")
+	assert.NoError(t, mock.ExpectationsWereMet(), "the result is not kept without its webhook")
+}
+
+func TestStore_GetDelivery(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectQuery("FROM webhook_deliveries WHERE id").WithArgs("d1").WillReturnRows(
+		sqlmock.NewRows(deliveryRowColumns).AddRow("d1", "abc", "acme", "https://example.com/hooks", "job.completed",
+			`{}`, "dead", 8, "timeout", fixedNow.UnixMilli(), fixedNow.UnixMilli(), fixedNow.UnixMilli(), 3))
+	mock.ExpectQuery("FROM webhook_deliveries WHERE id").WithArgs("missing").WillReturnError(sql.ErrNoRows)
+
+	delivery, found, err := store.GetDelivery(context.Background(), "d1")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, entity.DeliveryDead, delivery.Status)
+	assert.Equal(t, 8, delivery.Attempts)
+	assert.Equal(t, []byte(`{}`), delivery.Payload)
+	assert.Equal(t, fixedNow, delivery.NextAttemptAt)
+
+	_, found, err = store.GetDelivery(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_ClaimDueDeliveries(t *testing.T) {
+	store, mock := newTestStore(t)
+	until := fixedNow.Add(time.Minute)
+
+	mock.ExpectQuery("UPDATE webhook_deliveries SET claimed_until").
+		WithArgs(until.UnixMilli(), "pending", fixedNow.UnixMilli(), fixedNow.UnixMilli(), 50).
+		WillReturnRows(sqlmock.NewRows(deliveryRowColumns).
+			AddRow("d2", "abc", "acme", "u", "item.completed", `{}`, "pending", 2, "503", 20, 0, 0, 5).
+			AddRow("d1", "abc", "acme", "u", "job.completed", `{}`, "pending", 0, "", 10, 0, 0, 1))
+
+	due, err := store.ClaimDueDeliveries(context.Background(), fixedNow, until, 50)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, "d1", due[0].ID, "oldest due first")
+	assert.Equal(t, entity.EventItemCompleted, due[1].Event)
+	assert.Equal(t, "503", due[1].LastError)
+	assert.Equal(t, 5, due[1].Version)
+}
+
+func TestStore_UpdateDelivery(t *testing.T) {
+	store, mock := newTestStore(t)
+	delivery := &entity.WebhookDelivery{
+		ID: "d1", Status: entity.DeliveryDelivered, Attempts: 1, NextAttemptAt: fixedNow, UpdatedAt: fixedNow, Version: 4,
+	}
+
+	mock.ExpectExec("UPDATE webhook_deliveries").
+		WithArgs("delivered", 1, "", fixedNow.UnixMilli(), fixedNow.UnixMilli(), "d1", 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE webhook_deliveries").
+		WithArgs("delivered", 1, "", fixedNow.UnixMilli(), fixedNow.UnixMilli(), "d1", 4).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	stored, err := store.UpdateDelivery(context.Background(), delivery)
+	require.NoError(t, err)
+	assert.True(t, stored)
+
+	stored, err = store.UpdateDelivery(context.Background(), delivery)
+	require.NoError(t, err)
+	assert.False(t, stored, "a delivery changed since it was claimed is left alone")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStore_ResetDelivery(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	mock.ExpectExec("UPDATE webhook_deliveries").
+		WithArgs("pending", fixedNow.UnixMilli(), fixedNow.UnixMilli(), "d1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.ResetDelivery(context.Background(), "d1", fixedNow))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,96 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+)
+
+const (
+	defaultMaxAttempts = 5
+	defaultBaseBackoff = 100 * time.Millisecond
+	defaultMaxBackoff  = 2 * time.Second
+)
+
+// Handler processes one job. A returned error means the job should be delivered again.
+type Handler func(ctx context.Context, job *dto.ValidationJob) error
+
+// MemoryQueueConfig controls how MemoryQueue redelivers jobs whose handler failed.
+type MemoryQueueConfig struct {
+	// MaxAttempts is how many times a job is handled before it is dropped; zero means 5.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first redelivery, doubled for each later one;
+	// zero means 100ms.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between deliveries; zero means 2s.
+	MaxBackoff time.Duration
+}
+
+// MemoryQueue is an in-process ValidationJobQueue. The API and the workers share one
+// process, so the async pipeline can run without a broker.
+type MemoryQueue struct {
+	jobs   chan delivery
+	config MemoryQueueConfig
+}
+
+// delivery is a queued job and how many times it has been handled.
+type delivery struct {
+	job      *dto.ValidationJob
+	attempts int
+}
+
+// NewMemoryQueue creates a MemoryQueue buffering up to size jobs.
+func NewMemoryQueue(size int, config MemoryQueueConfig) *MemoryQueue {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.BaseBackoff <= 0 {
+		config.BaseBackoff = defaultBaseBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaultMaxBackoff
+	}
+
+	return &MemoryQueue{jobs: make(chan delivery, size), config: config}
+}
+
+// Publish enqueues job, blocking while the buffer is full until ctx is done.
+func (q *MemoryQueue) Publish(ctx context.Context, job *dto.ValidationJob) error {
+	select {
+	case q.jobs <- delivery{job: job}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Start runs workers goroutines handing queued jobs to handle. A job whose handler
+// fails is queued again after a backoff, the way a broker redelivers it, and dropped
+// after MaxAttempts.
+func (q *MemoryQueue) Start(workers int, handle Handler) {
+	for range max(workers, 1) {
+		go func() {
+			for d := range q.jobs {
+				if err := handle(context.Background(), d.job); err == nil {
+					continue
+				}
+
+				d.attempts++
+				if d.attempts >= q.config.MaxAttempts {
+					continue
+				}
+				time.AfterFunc(q.backoff(d.attempts), func() { q.jobs <- d })
+			}
+		}()
+	}
+}
+
+// backoff doubles BaseBackoff for each failed attempt, up to MaxBackoff.
+func (q *MemoryQueue) backoff(attempts int) time.Duration {
+	wait := q.config.BaseBackoff
+	for i := 1; i < attempts && wait < q.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, q.config.MaxBackoff)
+}
@@ -0,0 +1,61 @@
+// Package queue delivers validation jobs from the API to the worker.
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+)
+
+// ErrNotReady is returned when a job is published before the broker is bound.
+var ErrNotReady = errors.New("queue: publisher not bound yet")
+
+// Publisher is the subset of GoFr's pub/sub publisher (container.GetPublisher) the queue needs.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, message []byte) error
+}
+
+// PubSubQueue implements ValidationJobQueue on GoFr's pub/sub abstraction. As with
+// GoFr's other datasources, the publisher is only available once the app starts.
+type PubSubQueue struct {
+	topic string
+
+	mu        sync.RWMutex
+	publisher Publisher
+}
+
+// NewPubSubQueue creates a PubSubQueue publishing to topic.
+func NewPubSubQueue(topic string) *PubSubQueue {
+	return &PubSubQueue{topic: topic}
+}
+
+// SetPublisher binds the publisher, typically from an app.OnStart hook.
+func (q *PubSubQueue) SetPublisher(publisher Publisher) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.publisher = publisher
+}
+
+// Publisher returns the bound publisher, or nil before SetPublisher is called.
+func (q *PubSubQueue) Publisher() Publisher {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.publisher
+}
+
+// Publish sends job to the topic as JSON.
+func (q *PubSubQueue) Publish(ctx context.Context, job *dto.ValidationJob) error {
+	publisher := q.Publisher()
+	if publisher == nil {
+		return ErrNotReady
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(ctx, q.topic, data)
+}
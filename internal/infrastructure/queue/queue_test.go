@@ -0,0 +1,123 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+)
+
+type recordingPublisher struct {
+	topic   string
+	message []byte
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, topic string, message []byte) error {
+	p.topic = topic
+	p.message = message
+	return nil
+}
+
+func TestPubSubQueue_Publish(t *testing.T) {
+	q := NewPubSubQueue("address-validation")
+	job := &dto.ValidationJob{ID: "abc", Request: dto.ValidateRequest{Address: "123 Main St, Springfield, IL"}}
+
+	require.ErrorIs(t, q.Publish(context.Background(), job), ErrNotReady)
+
+	publisher := &recordingPublisher{}
+	q.SetPublisher(publisher)
+	require.NoError(t, q.Publish(context.Background(), job))
+
+	assert.Equal(t, "address-validation", publisher.topic)
+	var got dto.ValidationJob
+	require.NoError(t, json.Unmarshal(publisher.message, &got))
+	assert.Equal(t, *job, got)
+}
+
+func TestMemoryQueue_DeliversToWorkers(t *testing.T) {
+	q := NewMemoryQueue(4, MemoryQueueConfig{})
+	received := make(chan string, 2)
+	q.Start(2, func(_ context.Context, job *dto.ValidationJob) error {
+		received <- job.ID
+		return nil
+	})
+
+	require.NoError(t, q.Publish(context.Background(), &dto.ValidationJob{ID: "a"}))
+	require.NoError(t, q.Publish(context.Background(), &dto.ValidationJob{ID: "b"}))
+
+	var ids []string
+	for range 2 {
+		select {
+		case id := <-received:
+			ids = append(ids, id)
+		case <-time.After(time.Second):
+			t.Fatal("job was not delivered")
+		}
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, ids)
+}
+
+func TestMemoryQueue_RedeliversFailedJobs(t *testing.T) {
+	t.Run("a failed job is handled again", func(t *testing.T) {
+		q := NewMemoryQueue(1, MemoryQueueConfig{BaseBackoff: time.Millisecond})
+		attempts := make(chan int, 3)
+		calls := 0
+		q.Start(1, func(_ context.Context, _ *dto.ValidationJob) error {
+			calls++
+			attempts <- calls
+			if calls < 3 {
+				return errors.New("result store unavailable")
+			}
+			return nil
+		})
+
+		require.NoError(t, q.Publish(context.Background(), &dto.ValidationJob{ID: "a"}))
+
+		for want := 1; want <= 3; want++ {
+			select {
+			case got := <-attempts:
+				assert.Equal(t, want, got)
+			case <-time.After(time.Second):
+				t.Fatalf("attempt %d was not delivered", want)
+			}
+		}
+	})
+
+	t.Run("a job that keeps failing is dropped after MaxAttempts", func(t *testing.T) {
+		q := NewMemoryQueue(1, MemoryQueueConfig{MaxAttempts: 2, BaseBackoff: time.Millisecond})
+		attempts := make(chan struct{}, 3)
+		q.Start(1, func(_ context.Context, _ *dto.ValidationJob) error {
+			attempts <- struct{}{}
+			return errors.New("result store unavailable")
+		})
+
+		require.NoError(t, q.Publish(context.Background(), &dto.ValidationJob{ID: "a"}))
+
+		for range 2 {
+			select {
+			case <-attempts:
+			case <-time.After(time.Second):
+				t.Fatal("job was not redelivered")
+			}
+		}
+		select {
+		case <-attempts:
+			t.Fatal("job was delivered after MaxAttempts")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
+
+func TestMemoryQueue_PublishRespectsContext(t *testing.T) {
+	q := NewMemoryQueue(0, MemoryQueueConfig{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, q.Publish(ctx, &dto.ValidationJob{ID: "a"}), context.Canceled)
+}
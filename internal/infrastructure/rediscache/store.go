@@ -0,0 +1,84 @@
+// Package rediscache adapts GoFr's Redis datasource to the cache.Store interface.
+package rediscache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrNotReady is returned by Ping before the client is bound.
+var ErrNotReady = errors.New("rediscache: client not bound yet")
+
+// Client is the subset of GoFr's Redis datasource (container.Redis) the store needs.
+type Client interface {
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	Ping(ctx context.Context) *redis.StatusCmd
+}
+
+// Store implements cache.Store on Redis. GoFr only exposes its datasources once the
+// app starts, so the client is bound late; until then every lookup is a miss.
+type Store struct {
+	mu     sync.RWMutex
+	client Client
+}
+
+// NewStore creates a Store with no client bound.
+func NewStore() *Store {
+	return &Store{}
+}
+
+// SetClient binds the Redis client, typically from an app.OnStart hook.
+func (s *Store) SetClient(client Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.client = client
+}
+
+// Get returns the value stored under key.
+func (s *Store) Get(ctx context.Context, key string) (value []byte, found bool, err error) {
+	client := s.getClient()
+	if client == nil {
+		return nil, false, nil
+	}
+
+	data, err := client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+// Set stores value under key with the given TTL (zero means no expiry).
+func (s *Store) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	client := s.getClient()
+	if client == nil {
+		return nil
+	}
+
+	return client.Set(ctx, key, value, ttl).Err()
+}
+
+// Ping checks that the client is bound and Redis answers.
+func (s *Store) Ping(ctx context.Context) error {
+	client := s.getClient()
+	if client == nil {
+		return ErrNotReady
+	}
+
+	return client.Ping(ctx).Err()
+}
+
+func (s *Store) getClient() Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.client
+}
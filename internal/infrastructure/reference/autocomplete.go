@@ -0,0 +1,174 @@
+package reference
+
+import (
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+//go:embed data/zip_streets.csv
+var zipStreetsCSV string
+
+// prefixIndex answers prefix queries by binary search over keys kept in sorted order.
+type prefixIndex[T any] struct {
+	keys   []string
+	values []T
+}
+
+func (ix *prefixIndex[T]) add(key string, value T) {
+	ix.keys = append(ix.keys, key)
+	ix.values = append(ix.values, value)
+}
+
+// build orders the entries by key; call it once after the last add.
+func (ix *prefixIndex[T]) build() {
+	order := make([]int, len(ix.keys))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return ix.keys[order[a]] < ix.keys[order[b]] })
+
+	keys := make([]string, len(order))
+	values := make([]T, len(order))
+	for i, j := range order {
+		keys[i], values[i] = ix.keys[j], ix.values[j]
+	}
+	ix.keys, ix.values = keys, values
+}
+
+// match returns up to limit values, in key order, whose key starts with prefix and that keep accepts.
+func (ix *prefixIndex[T]) match(prefix string, keep func(T) bool, limit int) []T {
+	var out []T
+	for i := sort.SearchStrings(ix.keys, prefix); i < len(ix.keys) && len(out) < limit; i++ {
+		if !strings.HasPrefix(ix.keys[i], prefix) {
+			break
+		}
+		if keep(ix.values[i]) {
+			out = append(out, ix.values[i])
+		}
+	}
+	return out
+}
+
+// AutocompleteIndex implements AutocompleteRepository with in-memory prefix indexes
+// over the bundled ZIP and street reference data. It is read-only once built.
+type AutocompleteIndex struct {
+	zips    prefixIndex[entity.Locality]
+	cities  prefixIndex[entity.Locality]
+	streets prefixIndex[*entity.Street]
+}
+
+// NewAutocompleteIndex builds the indexes from the localities and the bundled streets.
+func NewAutocompleteIndex(localities *LocalityStore) (*AutocompleteIndex, error) {
+	records, err := csv.NewReader(strings.NewReader(zipStreetsCSV)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading zip streets: %w", err)
+	}
+
+	ix := &AutocompleteIndex{}
+
+	for zip, loc := range localities.byZIP {
+		ix.zips.add(zip, loc)
+	}
+	ix.zips.build()
+
+	// Walk the ZIPs in order so cities sharing a name are indexed in a stable order.
+	cities := make(map[entity.Locality]bool)
+	for _, loc := range ix.zips.values {
+		city := entity.Locality{City: loc.City, State: loc.State}
+		if !cities[city] {
+			cities[city] = true
+			ix.cities.add(normalizeKey(loc.City), city)
+		}
+	}
+	ix.cities.build()
+
+	for _, rec := range records[1:] {
+		loc, ok := localities.byZIP[rec[0]]
+		if !ok {
+			return nil, fmt.Errorf("zip streets: %s %q has no locality", rec[0], rec[1])
+		}
+
+		street := &entity.Street{Name: rec[1], Locality: loc}
+		for _, key := range streetKeys(rec[1]) {
+			ix.streets.add(key, street)
+		}
+	}
+
+	ix.streets.build()
+	return ix, nil
+}
+
+// MatchZIPs returns up to limit localities whose ZIP starts with prefix, in ZIP order.
+func (ix *AutocompleteIndex) MatchZIPs(_ context.Context, prefix string, limit int) []entity.Locality {
+	return ix.zips.match(prefix, func(entity.Locality) bool { return true }, limit)
+}
+
+// MatchCities returns up to limit cities whose name starts with prefix, limited to
+// state when it is set. The returned localities have no postal code.
+func (ix *AutocompleteIndex) MatchCities(_ context.Context, prefix, state string, limit int) []entity.Locality {
+	return ix.cities.match(normalizeKey(prefix), func(loc entity.Locality) bool {
+		return state == "" || loc.State == state
+	}, limit)
+}
+
+// MatchStreets returns up to limit streets whose name starts with prefix and that lie
+// near: an exact ZIP, a city starting with near.City and the exact state, each only
+// when set.
+func (ix *AutocompleteIndex) MatchStreets(_ context.Context, prefix string, near entity.Locality, limit int) []*entity.Street {
+	city := normalizeKey(near.City)
+	seen := make(map[*entity.Street]bool)
+
+	return ix.streets.match(normalizeKey(prefix), func(street *entity.Street) bool {
+		loc := street.Locality
+		if seen[street] ||
+			(near.PostalCode != "" && loc.PostalCode != near.PostalCode) ||
+			(near.State != "" && loc.State != near.State) ||
+			(city != "" && !strings.HasPrefix(normalizeKey(loc.City), city)) {
+			return false
+		}
+		seen[street] = true
+		return true
+	}, limit)
+}
+
+// Source reports the bundled data, which is a sample: most real streets and ZIP codes
+// are not in it, so a missing suggestion does not mean a missing address.
+func (ix *AutocompleteIndex) Source(context.Context) (name, coverage string) {
+	return "bundled/" + DatasetVersion, entity.CoverageSample
+}
+
+// normalizeKey lowercases s, drops periods and collapses whitespace, producing the
+// form the indexes are keyed by. A trailing space is kept so a finished word only
+// completes to longer phrases.
+func normalizeKey(s string) string {
+	key := strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(s, ".", ""))), " ")
+	if key != "" && strings.HasSuffix(s, " ") {
+		key += " "
+	}
+	return key
+}
+
+// streetKeys returns the keys a street is indexed under: its name as written and
+// with abbreviations expanded, each also without a leading directional.
+func streetKeys(name string) []string {
+	words := strings.Fields(normalizeKey(name))
+	expanded := make([]string, len(words))
+	for i, word := range words {
+		expanded[i] = word
+		if full, ok := entity.SpellOutStreetWord(word); ok {
+			expanded[i] = strings.ToLower(full)
+		}
+	}
+
+	keys := []string{strings.Join(words, " "), strings.Join(expanded, " ")}
+	if len(words) > 1 && entity.IsDirectional(words[0]) {
+		keys = append(keys, strings.Join(words[1:], " "), strings.Join(expanded[1:], " "))
+	}
+	return keys
+}
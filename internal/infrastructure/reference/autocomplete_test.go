@@ -0,0 +1,95 @@
+package reference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func newTestIndex(t *testing.T) *AutocompleteIndex {
+	t.Helper()
+
+	store, err := NewLocalityStore()
+	require.NoError(t, err)
+	ix, err := NewAutocompleteIndex(store)
+	require.NoError(t, err)
+	return ix
+}
+
+func streetNames(streets []*entity.Street) []string {
+	names := make([]string, len(streets))
+	for i, street := range streets {
+		names[i] = street.Name + " " + street.Locality.PostalCode
+	}
+	return names
+}
+
+func TestAutocompleteIndex_MatchZIPs(t *testing.T) {
+	ix := newTestIndex(t)
+	ctx := context.Background()
+
+	assert.Equal(t, []entity.Locality{
+		{PostalCode: "98101", City: "Seattle", State: "WA"},
+		{PostalCode: "98108", City: "Seattle", State: "WA"},
+	}, ix.MatchZIPs(ctx, "981", 10))
+	assert.Len(t, ix.MatchZIPs(ctx, "9", 3), 3, "limit caps the matches")
+	assert.Empty(t, ix.MatchZIPs(ctx, "00", 10))
+}
+
+func TestAutocompleteIndex_MatchCities(t *testing.T) {
+	ix := newTestIndex(t)
+	ctx := context.Background()
+
+	assert.Equal(t, []entity.Locality{{City: "Portland", State: "ME"}, {City: "Portland", State: "OR"}},
+		ix.MatchCities(ctx, "port", "", 10), "one entry per city and state")
+	assert.Equal(t, []entity.Locality{{City: "Portland", State: "OR"}}, ix.MatchCities(ctx, "Port", "OR", 10))
+	assert.Equal(t, []entity.Locality{{City: "New Orleans", State: "LA"}, {City: "New York", State: "NY"}},
+		ix.MatchCities(ctx, "new ", "", 10))
+	assert.Empty(t, ix.MatchCities(ctx, "newark ", "", 10), "a finished word does not match itself")
+}
+
+func TestAutocompleteIndex_MatchStreets(t *testing.T) {
+	ix := newTestIndex(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		prefix   string
+		near     entity.Locality
+		expected []string
+	}{
+		{"abbreviated", "w 3", entity.Locality{}, []string{"W 31st St 10001", "W 33rd St 10118", "W 34th St 10001"}},
+		{"expanded", "West 34th Str", entity.Locality{}, []string{"W 34th St 10001"}},
+		{"without directional", "34", entity.Locality{}, []string{"W 34th St 10001"}},
+		{"ZIP context", "market", entity.Locality{PostalCode: "94102"}, []string{"Market St 94102"}},
+		{"city prefix context", "market", entity.Locality{City: "phila"}, []string{"Market St 19103"}},
+		{"state context", "main", entity.Locality{State: "ID"}, []string{"W Main St 83702"}},
+		{"no context", "main", entity.Locality{}, []string{"Main St 02139", "W Main St 83702", "N Main St 90012"}},
+		{"context excludes everything", "market", entity.Locality{State: "TX"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, streetNames(ix.MatchStreets(ctx, tt.prefix, tt.near, 10)))
+		})
+	}
+}
+
+func TestAutocompleteIndex_StreetsHaveLocalities(t *testing.T) {
+	// NewAutocompleteIndex fails on a street whose ZIP is missing from zip_localities.csv.
+	ix := newTestIndex(t)
+	for _, street := range ix.streets.values {
+		assert.NotEmpty(t, street.Locality.City, street.Name)
+	}
+}
+
+func TestAutocompleteIndex_Source(t *testing.T) {
+	name, coverage := newTestIndex(t).Source(context.Background())
+
+	assert.Equal(t, "bundled/"+DatasetVersion, name)
+	assert.Equal(t, entity.CoverageSample, coverage)
+}
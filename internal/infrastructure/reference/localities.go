@@ -0,0 +1,88 @@
+package reference
+
+import (
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+// DatasetVersion identifies the bundled reference data; bump it whenever data/ changes.
+//
+// The bundled data is a sample, not a ZIP database: data/zip_localities.csv holds about
+// 130 ZIP codes with their cities, enough to exercise locality resolution in development
+// and tests. A ZIP code that is not in it is unknown. It still maps to a state through
+// its 3-digit prefix, but a state that disagrees with the prefix is never reported as a
+// conflict. Production deployments that need city resolution must replace the data.
+const DatasetVersion = "2026.10.2"
+
+//go:embed data/zip_localities.csv
+var zipLocalitiesCSV string
+
+// bundledLocalities parses the embedded ZIP localities once, keyed by 5-digit ZIP code.
+var bundledLocalities = sync.OnceValues(func() (map[string]entity.Locality, error) {
+	records, err := csv.NewReader(strings.NewReader(zipLocalitiesCSV)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading zip localities: %w", err)
+	}
+
+	byZIP := make(map[string]entity.Locality, len(records))
+	for _, rec := range records[1:] {
+		byZIP[rec[0]] = entity.Locality{PostalCode: rec[0], City: rec[1], State: rec[2]}
+	}
+	return byZIP, nil
+})
+
+// DatasetState returns the state of zip when the bundled dataset lists it. Unlike
+// StateForZIP, a miss means the ZIP code is unknown, not that it has no state.
+func DatasetState(zip string) (string, bool) {
+	byZIP, err := bundledLocalities()
+	if err != nil || len(zip) < 5 {
+		return "", false
+	}
+
+	loc, ok := byZIP[zip[:5]]
+	return loc.State, ok
+}
+
+// LocalityStore implements LocalityRepository from the bundled ZIP reference data.
+// ZIPs missing from the dataset still resolve to a state through their 3-digit prefix.
+type LocalityStore struct {
+	byZIP map[string]entity.Locality
+}
+
+// NewLocalityStore loads the bundled ZIP localities.
+func NewLocalityStore() (*LocalityStore, error) {
+	byZIP, err := bundledLocalities()
+	if err != nil {
+		return nil, err
+	}
+	return &LocalityStore{byZIP: byZIP}, nil
+}
+
+// LookupZIP resolves a 5-digit or ZIP+4 code to its locality.
+func (s *LocalityStore) LookupZIP(_ context.Context, zip string) (*entity.Locality, bool) {
+	if len(zip) < 5 {
+		return nil, false
+	}
+
+	if loc, ok := s.byZIP[zip[:5]]; ok {
+		loc.PostalCode = zip
+		return &loc, true
+	}
+
+	if state, ok := StateForZIP(zip); ok {
+		return &entity.Locality{PostalCode: zip, State: state}, true
+	}
+
+	return nil, false
+}
+
+// Len returns the number of ZIP codes with a known city.
+func (s *LocalityStore) Len() int {
+	return len(s.byZIP)
+}
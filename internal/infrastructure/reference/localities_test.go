@@ -0,0 +1,58 @@
+package reference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func TestLocalityStore_LookupZIP(t *testing.T) {
+	store, err := NewLocalityStore()
+	require.NoError(t, err)
+	require.Positive(t, store.Len())
+
+	tests := []struct {
+		name     string
+		zip      string
+		expected *entity.Locality
+	}{
+		{"known ZIP", "83702", &entity.Locality{PostalCode: "83702", City: "Boise", State: "ID"}},
+		{"ZIP+4 uses the 5-digit code", "10001-1234", &entity.Locality{PostalCode: "10001-1234", City: "New York", State: "NY"}},
+		{"unknown ZIP falls back to prefix state", "83814", &entity.Locality{PostalCode: "83814", State: "ID"}},
+		{"unassigned prefix", "00001", nil},
+		{"malformed", "123", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, ok := store.LookupZIP(context.Background(), tt.zip)
+			assert.Equal(t, tt.expected != nil, ok)
+			assert.Equal(t, tt.expected, loc)
+		})
+	}
+}
+
+func TestDatasetState(t *testing.T) {
+	state, ok := DatasetState("10001-1234")
+	assert.True(t, ok)
+	assert.Equal(t, "NY", state)
+
+	// 83814 is an Idaho ZIP code the sample dataset does not list.
+	_, ok = DatasetState("83814")
+	assert.False(t, ok)
+}
+
+func TestLocalityStore_DataMatchesPrefixes(t *testing.T) {
+	store, err := NewLocalityStore()
+	require.NoError(t, err)
+
+	for zip, loc := range store.byZIP {
+		state, ok := StateForZIP(zip)
+		require.True(t, ok, zip)
+		assert.Equal(t, state, loc.State, zip)
+	}
+}
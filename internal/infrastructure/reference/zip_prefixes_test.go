@@ -0,0 +1,32 @@
+package reference
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateForZIP(t *testing.T) {
+	tests := []struct {
+		zip      string
+		expected string
+		found    bool
+	}{
+		{"10001", "NY", true},
+		{"62701", "IL", true},
+		{"90210", "CA", true},
+		{"98108-1226", "WA", true},
+		{"20500", "DC", true},
+		{"00601", "", false},
+		{"1000", "", false},
+		{"ab123", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zip, func(t *testing.T) {
+			state, found := StateForZIP(tt.zip)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, state)
+		})
+	}
+}
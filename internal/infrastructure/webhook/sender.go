@@ -0,0 +1,89 @@
+// Package webhook sends job webhooks over HTTP.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+// maxErrorBody bounds how much of a failed response is kept for the delivery's last error.
+const maxErrorBody = 256
+
+// HTTPSender implements WebhookSender with a plain HTTP client.
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender creates an HTTPSender whose requests give up after timeout. It only
+// connects to public addresses, see entity.IsPublicCallbackAddr.
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return newHTTPSender(timeout, entity.IsPublicCallbackAddr)
+}
+
+// newHTTPSender creates an HTTPSender that only connects to addresses allow accepts. The
+// check runs on the resolved address as each connection is made, redirects included, so
+// a callback host that resolves to a private address after it was accepted (DNS
+// rebinding) is still refused. Proxies are not used, as they would hide the address.
+func newHTTPSender(timeout time.Duration, allow func(netip.Addr) bool) *HTTPSender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !allow(addrPort.Addr()) {
+				return fmt.Errorf("webhook host resolves to %s, which webhooks may not reach", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: timeout,
+	}
+	return &HTTPSender{client: &http.Client{Timeout: timeout, Transport: transport}}
+}
+
+// Send POSTs body as JSON to url. Any non-2xx response is an error.
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "address-validation-service-webhooks")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if len(snippet) == 0 {
+		return fmt.Errorf("webhook endpoint responded %s", resp.Status)
+	}
+	return fmt.Errorf("webhook endpoint responded %s: %s", resp.Status, bytes.TrimSpace(snippet))
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// allowAll lets tests reach httptest servers, which listen on loopback.
+func allowAll(netip.Addr) bool { return true }
+
+func TestHTTPSender_Send(t *testing.T) {
+	var (
+		gotHeader http.Header
+		gotBody   []byte
+		status    = http.StatusNoContent
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		if status >= 300 {
+			_, _ = w.Write([]byte("try again later\n"))
+		}
+	}))
+	defer server.Close()
+
+	sender := newHTTPSender(time.Second, allowAll)
+
+	err := sender.Send(context.Background(), server.URL, map[string]string{"X-Webhook-Event": "job.completed"}, []byte(`{"ok":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+	assert.Equal(t, "job.completed", gotHeader.Get("X-Webhook-Event"))
+	assert.Equal(t, `{"ok":true}`, string(gotBody))
+
+	status = http.StatusServiceUnavailable
+	err = sender.Send(context.Background(), server.URL, nil, []byte(`{}`))
+	require.Error(t, err)
+	assert.Equal(t, "webhook endpoint responded 503 Service Unavailable: try again later", err.Error())
+}
+
+func TestHTTPSender_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	err := newHTTPSender(20*time.Millisecond, allowAll).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestHTTPSender_RefusesNonPublicAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	err := NewHTTPSender(time.Second).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "which webhooks may not reach")
+	assert.False(t, called)
+}
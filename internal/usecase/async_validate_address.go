@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// resultPollInterval is how often a waiting request checks for the worker's result.
+const resultPollInterval = 50 * time.Millisecond
+
+// AsyncValidateAddressConfig holds the settings for AsyncValidateAddressUsecase.
+type AsyncValidateAddressConfig struct {
+	// DefaultStrictness must match the worker's so fail-fast checks agree; empty means standard.
+	DefaultStrictness entity.Strictness
+	// WaitTimeout bounds how long a request waits for the worker's result.
+	WaitTimeout time.Duration
+}
+
+// AsyncValidateAddressUsecase validates addresses by publishing them for the worker
+// and waiting for the result it stores. It implements ValidateAddressUsecaseInterface,
+// so handlers do not know which pipeline serves them.
+type AsyncValidateAddressUsecase struct {
+	queue   ValidationJobQueue
+	results ValidationResultStore
+	config  AsyncValidateAddressConfig
+}
+
+// NewAsyncValidateAddressUsecase creates a new AsyncValidateAddressUsecase.
+func NewAsyncValidateAddressUsecase(
+	queue ValidationJobQueue,
+	results ValidationResultStore,
+	config AsyncValidateAddressConfig,
+) *AsyncValidateAddressUsecase {
+	if config.DefaultStrictness == "" {
+		config.DefaultStrictness = entity.StrictnessStandard
+	}
+	return &AsyncValidateAddressUsecase{queue: queue, results: results, config: config}
+}
+
+// Execute returns a stored result for an identical request, or publishes the request
+// and waits up to WaitTimeout for the worker to store one.
+func (uc *AsyncValidateAddressUsecase) Execute(ctx context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+	if _, err := resolveRequest(input, uc.config.DefaultStrictness); err != nil {
+		return nil, err
+	}
+
+	id, err := jobID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if result, ok, err := uc.results.LoadResult(ctx, id); err != nil {
+		return nil, err
+	} else if ok {
+		return unpackResult(result)
+	}
+
+	if err := uc.queue.Publish(ctx, &dto.ValidationJob{ID: id, Request: *input}); err != nil {
+		return nil, fmt.Errorf("publishing validation job: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uc.config.WaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(resultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, &domainerrors.TimeoutError{
+				Reason: fmt.Sprintf("no validation result after %s", uc.config.WaitTimeout),
+			}
+		case <-ticker.C:
+			result, ok, err := uc.results.LoadResult(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return unpackResult(result)
+			}
+		}
+	}
+}
+
+// jobID derives the job ID from the request, so identical requests share a result.
+func jobID(input *dto.ValidateRequest) (string, error) {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func unpackResult(result *dto.ValidationJobResult) (*dto.ValidateResponse, error) {
+	if result.Error != nil {
+		return nil, result.Error.Err()
+	}
+	return result.Response, nil
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+type fakeResultStore struct {
+	mu      sync.Mutex
+	results map[string]*dto.ValidationJobResult
+}
+
+func newFakeResultStore() *fakeResultStore {
+	return &fakeResultStore{results: make(map[string]*dto.ValidationJobResult)}
+}
+
+func (s *fakeResultStore) SaveResult(_ context.Context, id string, result *dto.ValidationJobResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[id] = result
+	return nil
+}
+
+func (s *fakeResultStore) LoadResult(_ context.Context, id string) (*dto.ValidationJobResult, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result, ok := s.results[id]
+	return result, ok, nil
+}
+
+// fakeQueue hands each published job to process in the background, like a worker would.
+type fakeQueue struct {
+	mu        sync.Mutex
+	published []*dto.ValidationJob
+	process   func(job *dto.ValidationJob)
+}
+
+func (q *fakeQueue) Publish(_ context.Context, job *dto.ValidationJob) error {
+	q.mu.Lock()
+	q.published = append(q.published, job)
+	q.mu.Unlock()
+
+	if q.process != nil {
+		go q.process(job)
+	}
+	return nil
+}
+
+func (q *fakeQueue) count() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.published)
+}
+
+func TestAsyncValidateAddressUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	request := &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701"}
+
+	t.Run("fails fast without publishing", func(t *testing.T) {
+		queue := &fakeQueue{}
+		uc := NewAsyncValidateAddressUsecase(queue, newFakeResultStore(), AsyncValidateAddressConfig{WaitTimeout: time.Second})
+
+		_, err := uc.Execute(ctx, &dto.ValidateRequest{Address: " "})
+
+		var ve *domainerrors.ValidationError
+		require.ErrorAs(t, err, &ve)
+		assert.Zero(t, queue.count())
+	})
+
+	t.Run("waits for the worker's result", func(t *testing.T) {
+		results := newFakeResultStore()
+		queue := &fakeQueue{process: func(job *dto.ValidationJob) {
+			time.Sleep(2 * resultPollInterval)
+			_ = results.SaveResult(ctx, job.ID, &dto.ValidationJobResult{
+				Response: &dto.ValidateResponse{Success: true, Status: dto.StatusValid},
+			})
+		}}
+		uc := NewAsyncValidateAddressUsecase(queue, results, AsyncValidateAddressConfig{WaitTimeout: time.Second})
+
+		resp, err := uc.Execute(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, dto.StatusValid, resp.Status)
+
+		// The stored result answers an identical request without publishing again.
+		_, err = uc.Execute(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, 1, queue.count())
+	})
+
+	t.Run("rebuilds the worker's domain error", func(t *testing.T) {
+		results := newFakeResultStore()
+		queue := &fakeQueue{process: func(job *dto.ValidationJob) {
+			_ = results.SaveResult(ctx, job.ID, &dto.ValidationJobResult{
+				Error: dto.NewValidationJobError(&domainerrors.ParsingError{Field: "address", Reason: "invalid state code: XX"}),
+			})
+		}}
+		uc := NewAsyncValidateAddressUsecase(queue, results, AsyncValidateAddressConfig{WaitTimeout: time.Second})
+
+		_, err := uc.Execute(ctx, request)
+
+		var pe *domainerrors.ParsingError
+		require.ErrorAs(t, err, &pe)
+		assert.Equal(t, "invalid state code: XX", pe.Reason)
+	})
+
+	t.Run("rebuilds every problem the worker reported", func(t *testing.T) {
+		results := newFakeResultStore()
+		queue := &fakeQueue{process: func(job *dto.ValidationJob) {
+			_ = results.SaveResult(ctx, job.ID, &dto.ValidationJobResult{
+				Error: dto.NewValidationJobError(errors.Join(
+					&domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "address", Reason: "invalid state code: XX"},
+					&domainerrors.ParsingError{Code: domainerrors.CodeZIPFormat, Field: "address", Reason: "postal_code must be 5 or 9-digit format"},
+				)),
+			})
+		}}
+		uc := NewAsyncValidateAddressUsecase(queue, results, AsyncValidateAddressConfig{WaitTimeout: time.Second})
+
+		_, err := uc.Execute(ctx, request)
+
+		problems := domainerrors.Problems(err)
+		require.Len(t, problems, 2)
+		assert.Equal(t, domainerrors.CodeStateInvalid, domainerrors.CodeOf(problems[0]))
+		assert.Equal(t, domainerrors.CodeZIPFormat, domainerrors.CodeOf(problems[1]))
+	})
+
+	t.Run("times out when no result arrives", func(t *testing.T) {
+		uc := NewAsyncValidateAddressUsecase(&fakeQueue{}, newFakeResultStore(), AsyncValidateAddressConfig{WaitTimeout: 3 * resultPollInterval})
+
+		_, err := uc.Execute(ctx, request)
+
+		var te *domainerrors.TimeoutError
+		require.ErrorAs(t, err, &te)
+	})
+}
+
+func TestProcessValidationJobUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	job := &dto.ValidationJob{ID: "job-1", Request: dto.ValidateRequest{Address: "123 Main St"}}
+
+	t.Run("stores the response", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validator := NewMockValidateAddressUsecaseInterface(ctrl)
+		validator.EXPECT().Execute(gomock.Any(), &job.Request).Return(&dto.ValidateResponse{Success: true}, nil)
+
+		results := newFakeResultStore()
+		require.NoError(t, NewProcessValidationJobUsecase(validator, results).Execute(ctx, job))
+
+		stored, ok, _ := results.LoadResult(ctx, "job-1")
+		require.True(t, ok)
+		assert.True(t, stored.Response.Success)
+		assert.Nil(t, stored.Error)
+	})
+
+	t.Run("stores validation failures as results", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validator := NewMockValidateAddressUsecaseInterface(ctrl)
+		validator.EXPECT().Execute(gomock.Any(), gomock.Any()).
+			Return(nil, &domainerrors.ValidationError{Field: "mode", Reason: "bad mode"})
+
+		results := newFakeResultStore()
+		require.NoError(t, NewProcessValidationJobUsecase(validator, results).Execute(ctx, job))
+
+		stored, ok, _ := results.LoadResult(ctx, "job-1")
+		require.True(t, ok)
+		require.NotNil(t, stored.Error)
+		assert.Equal(t, dto.JobErrorValidation, stored.Error.Kind)
+	})
+
+	t.Run("returns other failures without storing them so the job is redelivered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validator := NewMockValidateAddressUsecaseInterface(ctrl)
+		validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, context.Canceled)
+
+		results := newFakeResultStore()
+		err := NewProcessValidationJobUsecase(validator, results).Execute(ctx, job)
+		require.ErrorIs(t, err, context.Canceled)
+
+		_, ok, _ := results.LoadResult(ctx, "job-1")
+		assert.False(t, ok)
+	})
+
+	t.Run("returns store failures so the job is redelivered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validator := NewMockValidateAddressUsecaseInterface(ctrl)
+		validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&dto.ValidateResponse{Success: true}, nil)
+
+		err := NewProcessValidationJobUsecase(validator, failingResultStore{}).Execute(ctx, job)
+		require.Error(t, err)
+	})
+}
+
+type failingResultStore struct{}
+
+func (failingResultStore) SaveResult(context.Context, string, *dto.ValidationJobResult) error {
+	return errors.New("store unavailable")
+}
+
+func (failingResultStore) LoadResult(context.Context, string) (*dto.ValidationJobResult, bool, error) {
+	return nil, false, nil
+}
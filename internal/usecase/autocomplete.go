@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+const (
+	// defaultAutocompleteLimit and maxAutocompleteLimit bound the suggestions per request.
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 25
+	maxAutocompleteQuery     = 200
+	// autocompleteCandidates is how many index matches are ranked before the limit applies.
+	autocompleteCandidates = 100
+)
+
+var (
+	// houseNumberPattern splits "123 Mai" into the house number and the street prefix;
+	// the prefix keeps its trailing space so a finished word is not completed further.
+	houseNumberPattern = regexp.MustCompile(`^(\d+[A-Za-z]?(?:-\d+)?)\s+(.*)$`)
+	zipPrefixPattern   = regexp.MustCompile(`^\d+$`)
+	zipPattern         = regexp.MustCompile(`^\d{5}$`)
+)
+
+//go:generate mockgen -destination=autocomplete_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase AutocompleteUsecaseInterface
+type AutocompleteUsecaseInterface interface {
+	Execute(ctx context.Context, input *dto.AutocompleteRequest) (*dto.AutocompleteResponse, error)
+}
+
+// AutocompleteUsecase suggests completions for partially typed addresses from reference data.
+//
+// The query is read by its first comma-separated part: digits complete a ZIP code,
+// a house number followed by text completes a street, and anything else completes
+// a city. Later parts are context: a 5-digit ZIP, a state code, or city words.
+type AutocompleteUsecase struct {
+	repo AutocompleteRepository
+}
+
+// NewAutocompleteUsecase creates a new AutocompleteUsecase.
+func NewAutocompleteUsecase(repo AutocompleteRepository) *AutocompleteUsecase {
+	return &AutocompleteUsecase{repo: repo}
+}
+
+// Execute returns up to input.Limit suggestions for input.Query, best first.
+func (uc *AutocompleteUsecase) Execute(ctx context.Context, input *dto.AutocompleteRequest) (*dto.AutocompleteResponse, error) {
+	query := strings.TrimLeft(input.Query, " \t")
+	if strings.TrimSpace(query) == "" {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldRequired,
+			Field:      "q",
+			Reason:     "q is required and cannot be empty",
+			Suggestion: "Provide the partial address typed so far",
+		}
+	}
+	if len(query) > maxAutocompleteQuery {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeLimitExceeded,
+			Field:      "q",
+			Reason:     "q is too long",
+			Value:      len(query),
+			Suggestion: "Send at most 200 characters",
+		}
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultAutocompleteLimit
+	}
+	if limit < 0 || limit > maxAutocompleteLimit {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldOutOfRange,
+			Field:      "limit",
+			Reason:     "limit must be between 1 and 25",
+			Value:      input.Limit,
+			Suggestion: "Omit limit to get 10 suggestions",
+		}
+	}
+
+	parts := strings.Split(query, ",")
+	near, err := autocompleteContext(parts[1:], input)
+	if err != nil {
+		return nil, err
+	}
+
+	// A part followed by a comma is finished, so only a trailing first part keeps its space.
+	first := parts[0]
+	if len(parts) > 1 {
+		first = strings.TrimSpace(first)
+	}
+
+	var suggestions []*dto.SuggestionDTO
+	if zipPrefixPattern.MatchString(first) {
+		suggestions = uc.zips(ctx, first)
+	} else if m := houseNumberPattern.FindStringSubmatch(first); m != nil {
+		suggestions = uc.streets(ctx, m[1], m[2], near)
+	} else {
+		suggestions = uc.cities(ctx, first, near.State)
+	}
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	source, coverage := uc.repo.Source(ctx)
+	return &dto.AutocompleteResponse{
+		Success:     true,
+		Query:       input.Query,
+		Suggestions: suggestions,
+		Source:      source,
+		Coverage:    coverage,
+		Message:     fmt.Sprintf("Found %d suggestions", len(suggestions)),
+	}, nil
+}
+
+func (uc *AutocompleteUsecase) zips(ctx context.Context, prefix string) []*dto.SuggestionDTO {
+	suggestions := []*dto.SuggestionDTO{}
+	for _, loc := range uc.repo.MatchZIPs(ctx, prefix, autocompleteCandidates) {
+		suggestions = append(suggestions, &dto.SuggestionDTO{
+			Type:       dto.SuggestionZIP,
+			Text:       loc.PostalCode + " " + loc.City + ", " + loc.State,
+			City:       loc.City,
+			State:      loc.State,
+			PostalCode: loc.PostalCode,
+			Score:      completeness(prefix, loc.PostalCode),
+		})
+	}
+	// The index returns ZIPs in order and they all share a length, so no ranking is needed.
+	return suggestions
+}
+
+func (uc *AutocompleteUsecase) cities(ctx context.Context, prefix, state string) []*dto.SuggestionDTO {
+	suggestions := []*dto.SuggestionDTO{}
+	for _, loc := range uc.repo.MatchCities(ctx, prefix, state, autocompleteCandidates) {
+		suggestions = append(suggestions, &dto.SuggestionDTO{
+			Type:  dto.SuggestionCity,
+			Text:  loc.City + ", " + loc.State,
+			City:  loc.City,
+			State: loc.State,
+			Score: completeness(prefix, loc.City),
+		})
+	}
+	rankSuggestions(suggestions)
+	return suggestions
+}
+
+func (uc *AutocompleteUsecase) streets(ctx context.Context, house, prefix string, near entity.Locality) []*dto.SuggestionDTO {
+	suggestions := []*dto.SuggestionDTO{}
+	if strings.TrimSpace(prefix) == "" {
+		return suggestions
+	}
+
+	for _, street := range uc.repo.MatchStreets(ctx, prefix, near, autocompleteCandidates) {
+		loc := street.Locality
+		line := house + " " + street.Name
+		suggestions = append(suggestions, &dto.SuggestionDTO{
+			Type:          dto.SuggestionStreet,
+			Text:          line + ", " + loc.City + ", " + loc.State + " " + loc.PostalCode,
+			StreetAddress: line,
+			City:          loc.City,
+			State:         loc.State,
+			PostalCode:    loc.PostalCode,
+			Score:         completeness(prefix, street.Name),
+		})
+	}
+	rankSuggestions(suggestions)
+	return suggestions
+}
+
+// autocompleteContext reads the ZIP, state and city typed after the first comma,
+// then applies the explicit zip, city and state parameters over them.
+func autocompleteContext(parts []string, input *dto.AutocompleteRequest) (entity.Locality, error) {
+	var near entity.Locality
+	var city []string
+	for _, part := range parts {
+		for _, token := range strings.Fields(part) {
+			switch {
+			case zipPattern.MatchString(token):
+				near.PostalCode = token
+			case len(token) == 2 && entity.ValidUSStates[strings.ToUpper(token)]:
+				near.State = strings.ToUpper(token)
+			default:
+				city = append(city, token)
+			}
+		}
+	}
+	near.City = strings.Join(city, " ")
+
+	if input.ZIP != "" {
+		if !zipPattern.MatchString(input.ZIP) {
+			return near, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeZIPFormat,
+				Field:      "zip",
+				Reason:     "zip must be a 5-digit ZIP code",
+				Value:      input.ZIP,
+				Suggestion: "Use the format 12345",
+			}
+		}
+		near.PostalCode = input.ZIP
+	}
+	if input.State != "" {
+		state := strings.ToUpper(input.State)
+		if !entity.ValidUSStates[state] {
+			return near, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeStateInvalid,
+				Field:      "state",
+				Reason:     "state must be a 2-letter USPS state code",
+				Value:      input.State,
+				Suggestion: "Use a code such as IL or CA",
+			}
+		}
+		near.State = state
+	}
+	if input.City != "" {
+		near.City = input.City
+	}
+	return near, nil
+}
+
+// completeness scores how much of name the prefix already covers, so the closest
+// completions rank first.
+func completeness(prefix, name string) float64 {
+	typed := len(strings.Join(strings.Fields(prefix), " "))
+	full := len(strings.Join(strings.Fields(name), " "))
+	if full == 0 {
+		return 0
+	}
+	return math.Round(min(float64(typed)/float64(full), 1)*100) / 100
+}
+
+// rankSuggestions orders suggestions by score, then alphabetically for a stable order.
+func rankSuggestions(suggestions []*dto.SuggestionDTO) {
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Text < suggestions[j].Text
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: AutocompleteUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=autocomplete_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase AutocompleteUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAutocompleteUsecaseInterface is a mock of AutocompleteUsecaseInterface interface.
+type MockAutocompleteUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutocompleteUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAutocompleteUsecaseInterfaceMockRecorder is the mock recorder for MockAutocompleteUsecaseInterface.
+type MockAutocompleteUsecaseInterfaceMockRecorder struct {
+	mock *MockAutocompleteUsecaseInterface
+}
+
+// NewMockAutocompleteUsecaseInterface creates a new mock instance.
+func NewMockAutocompleteUsecaseInterface(ctrl *gomock.Controller) *MockAutocompleteUsecaseInterface {
+	mock := &MockAutocompleteUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAutocompleteUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutocompleteUsecaseInterface) EXPECT() *MockAutocompleteUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAutocompleteUsecaseInterface) Execute(ctx context.Context, input *dto.AutocompleteRequest) (*dto.AutocompleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*dto.AutocompleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAutocompleteUsecaseInterfaceMockRecorder) Execute(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAutocompleteUsecaseInterface)(nil).Execute), ctx, input)
+}
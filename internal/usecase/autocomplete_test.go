@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// fakeAutocompleteRepository returns canned matches and records what it was asked.
+type fakeAutocompleteRepository struct {
+	zips    []entity.Locality
+	cities  []entity.Locality
+	streets []*entity.Street
+
+	lookup string
+	prefix string
+	state  string
+	near   entity.Locality
+}
+
+func (r *fakeAutocompleteRepository) MatchZIPs(_ context.Context, prefix string, _ int) []entity.Locality {
+	r.lookup, r.prefix = "zips", prefix
+	return r.zips
+}
+
+func (r *fakeAutocompleteRepository) MatchCities(_ context.Context, prefix, state string, _ int) []entity.Locality {
+	r.lookup, r.prefix, r.state = "cities", prefix, state
+	return r.cities
+}
+
+func (r *fakeAutocompleteRepository) MatchStreets(_ context.Context, prefix string, near entity.Locality, _ int) []*entity.Street {
+	r.lookup, r.prefix, r.near = "streets", prefix, near
+	return r.streets
+}
+
+func (r *fakeAutocompleteRepository) Source(context.Context) (name, coverage string) {
+	return "bundled/test", entity.CoverageSample
+}
+
+func TestAutocompleteUsecase_Execute(t *testing.T) {
+	cambridge := entity.Locality{PostalCode: "02139", City: "Cambridge", State: "MA"}
+	boise := entity.Locality{PostalCode: "83702", City: "Boise", State: "ID"}
+
+	repo := &fakeAutocompleteRepository{
+		zips: []entity.Locality{
+			{PostalCode: "62701", City: "Springfield", State: "IL"},
+			{PostalCode: "63101", City: "Saint Louis", State: "MO"},
+		},
+		cities: []entity.Locality{{City: "Portland", State: "OR"}, {City: "Port", State: "ME"}, {City: "Portland", State: "ME"}},
+		streets: []*entity.Street{
+			{Name: "W Main St", Locality: boise},
+			{Name: "Main St", Locality: cambridge},
+		},
+	}
+	uc := NewAutocompleteUsecase(repo)
+
+	tests := []struct {
+		name         string
+		input        *dto.AutocompleteRequest
+		expectLookup string
+		expectPrefix string
+		expectState  string
+		expectNear   entity.Locality
+		expectTexts  []string
+		expectType   string
+	}{
+		{
+			name:         "digits complete ZIP codes",
+			input:        &dto.AutocompleteRequest{Query: "6"},
+			expectLookup: "zips",
+			expectPrefix: "6",
+			expectTexts:  []string{"62701 Springfield, IL", "63101 Saint Louis, MO"},
+			expectType:   dto.SuggestionZIP,
+		},
+		{
+			name:         "cities rank the closest completion first",
+			input:        &dto.AutocompleteRequest{Query: "Port"},
+			expectLookup: "cities",
+			expectPrefix: "Port",
+			expectTexts:  []string{"Port, ME", "Portland, ME", "Portland, OR"},
+			expectType:   dto.SuggestionCity,
+		},
+		{
+			name:         "state after a comma narrows cities",
+			input:        &dto.AutocompleteRequest{Query: "Port, or"},
+			expectLookup: "cities",
+			expectPrefix: "Port",
+			expectState:  "OR",
+			expectTexts:  []string{"Port, ME", "Portland, ME", "Portland, OR"},
+		},
+		{
+			name:         "house number completes streets",
+			input:        &dto.AutocompleteRequest{Query: "123 Main ", Limit: 1},
+			expectLookup: "streets",
+			expectPrefix: "Main ",
+			expectTexts:  []string{"123 Main St, Cambridge, MA 02139"},
+			expectType:   dto.SuggestionStreet,
+		},
+		{
+			name:         "typed context narrows streets",
+			input:        &dto.AutocompleteRequest{Query: "123 Main St, Salt Lake City UT 84101"},
+			expectLookup: "streets",
+			expectPrefix: "Main St",
+			expectNear:   entity.Locality{PostalCode: "84101", City: "Salt Lake City", State: "UT"},
+			expectTexts:  []string{"123 Main St, Cambridge, MA 02139", "123 W Main St, Boise, ID 83702"},
+		},
+		{
+			name:         "parameters override typed context",
+			input:        &dto.AutocompleteRequest{Query: "9 Ma, Boston", ZIP: "83702", City: "Boise", State: "id"},
+			expectLookup: "streets",
+			expectPrefix: "Ma",
+			expectNear:   entity.Locality{PostalCode: "83702", City: "Boise", State: "ID"},
+			expectTexts:  []string{"9 Main St, Cambridge, MA 02139", "9 W Main St, Boise, ID 83702"},
+		},
+		{
+			name:        "house number alone has nothing to complete",
+			input:       &dto.AutocompleteRequest{Query: "123 "},
+			expectTexts: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*repo = fakeAutocompleteRepository{zips: repo.zips, cities: repo.cities, streets: repo.streets}
+
+			resp, err := uc.Execute(context.Background(), tt.input)
+			require.NoError(t, err)
+
+			assert.True(t, resp.Success)
+			assert.Equal(t, tt.expectLookup, repo.lookup)
+			assert.Equal(t, tt.expectPrefix, repo.prefix)
+			assert.Equal(t, tt.expectState, repo.state)
+			assert.Equal(t, tt.expectNear, repo.near)
+
+			texts := []string{}
+			for _, suggestion := range resp.Suggestions {
+				texts = append(texts, suggestion.Text)
+			}
+			assert.Equal(t, tt.expectTexts, texts)
+			if tt.expectType != "" {
+				assert.Equal(t, tt.expectType, resp.Suggestions[0].Type)
+			}
+		})
+	}
+}
+
+func TestAutocompleteUsecase_Execute_Scores(t *testing.T) {
+	repo := &fakeAutocompleteRepository{cities: []entity.Locality{{City: "Springfield", State: "IL"}}}
+
+	resp, err := NewAutocompleteUsecase(repo).Execute(context.Background(), &dto.AutocompleteRequest{Query: "spring"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Suggestions, 1)
+	assert.Equal(t, 0.55, resp.Suggestions[0].Score)
+	assert.Equal(t, "Springfield", resp.Suggestions[0].City)
+	assert.Equal(t, "IL", resp.Suggestions[0].State)
+}
+
+func TestAutocompleteUsecase_Execute_ReportsCoverage(t *testing.T) {
+	resp, err := NewAutocompleteUsecase(&fakeAutocompleteRepository{}).Execute(context.Background(), &dto.AutocompleteRequest{Query: "spring"})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Suggestions)
+	assert.Equal(t, "bundled/test", resp.Source)
+	assert.Equal(t, entity.CoverageSample, resp.Coverage)
+}
+
+func TestAutocompleteUsecase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *dto.AutocompleteRequest
+		expectField string
+	}{
+		{"missing query", &dto.AutocompleteRequest{Query: "  "}, "q"},
+		{"query too long", &dto.AutocompleteRequest{Query: strings.Repeat("a", 201)}, "q"},
+		{"limit too large", &dto.AutocompleteRequest{Query: "spr", Limit: 26}, "limit"},
+		{"negative limit", &dto.AutocompleteRequest{Query: "spr", Limit: -1}, "limit"},
+		{"malformed zip", &dto.AutocompleteRequest{Query: "1 Main", ZIP: "8370"}, "zip"},
+		{"unknown state", &dto.AutocompleteRequest{Query: "spr", State: "XX"}, "state"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAutocompleteUsecase(&fakeAutocompleteRepository{}).Execute(context.Background(), tt.input)
+
+			var ve *domainerrors.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.expectField, ve.Field)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// Component names reported by CompareAddressesUsecase.
+const (
+	compareStreetNumber = "street_number"
+	compareStreetName   = "street_name"
+	compareUnit         = "unit"
+)
+
+// compareWeights sets how much each component contributes to the overall similarity.
+var compareWeights = map[string]float64{
+	compareStreetNumber:        0.25,
+	compareStreetName:          0.3,
+	compareUnit:                0.1,
+	entity.ComponentCity:       0.1,
+	entity.ComponentState:      0.05,
+	entity.ComponentPostalCode: 0.2,
+}
+
+//go:generate mockgen -destination=compare_addresses_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase CompareAddressesUsecaseInterface
+type CompareAddressesUsecaseInterface interface {
+	Execute(ctx context.Context, input *dto.CompareRequest) (*dto.CompareResponse, error)
+}
+
+// CompareAddressesUsecase decides whether two addresses are the same place. Both
+// are normalized by the validator, then compared component by component in USPS
+// form, so case and abbreviation differences do not count as mismatches.
+type CompareAddressesUsecase struct {
+	validator ValidateAddressUsecaseInterface
+}
+
+// NewCompareAddressesUsecase creates a new CompareAddressesUsecase.
+func NewCompareAddressesUsecase(validator ValidateAddressUsecaseInterface) *CompareAddressesUsecase {
+	return &CompareAddressesUsecase{validator: validator}
+}
+
+// Execute normalizes both addresses and returns the verdict with a per-component comparison.
+func (uc *CompareAddressesUsecase) Execute(ctx context.Context, input *dto.CompareRequest) (*dto.CompareResponse, error) {
+	a, err := uc.normalize(ctx, "address_a", input.AddressA, input)
+	if err != nil {
+		return nil, err
+	}
+	b, err := uc.normalize(ctx, "address_b", input.AddressB, input)
+	if err != nil {
+		return nil, err
+	}
+
+	components := compareAddresses(a, b)
+	verdict := compareVerdict(components)
+
+	return &dto.CompareResponse{
+		Success:    true,
+		Verdict:    verdict,
+		Similarity: compareSimilarity(components),
+		AddressA:   a,
+		AddressB:   b,
+		Components: components,
+		Message:    compareMessages[verdict],
+	}, nil
+}
+
+var compareMessages = map[string]string{
+	dto.MatchExact:        "Addresses are the same place",
+	dto.MatchSameBuilding: "Addresses are in the same building with different units",
+	dto.MatchSameStreet:   "Addresses are on the same street",
+	dto.MatchDifferent:    "Addresses are different places",
+}
+
+func (uc *CompareAddressesUsecase) normalize(ctx context.Context, field, address string, input *dto.CompareRequest) (*dto.AddressDTO, error) {
+	resp, err := uc.validator.Execute(ctx, &dto.ValidateRequest{
+		Address:    address,
+		Mode:       input.Mode,
+		Strictness: input.Strictness,
+	})
+	if err != nil {
+		return nil, qualifyAddressError(err, field)
+	}
+	if resp.Address == nil {
+		return nil, &domainerrors.ParsingError{
+			Code:   domainerrors.CodeAddressUnparseable,
+			Field:  field,
+			Reason: "address could not be normalized",
+			Value:  address,
+		}
+	}
+	return resp.Address, nil
+}
+
+// qualifyAddressError renames the fields of an address error after the request field
+// it came from, so "address" becomes "address_b" and "state" becomes "address_b.state".
+// Option errors such as mode apply to both addresses and keep their field. Each of
+// several joined problems is renamed.
+func qualifyAddressError(err error, field string) error {
+	problems := domainerrors.Problems(err)
+	if len(problems) > 1 {
+		qualified := make([]error, 0, len(problems))
+		for _, problem := range problems {
+			qualified = append(qualified, qualifyAddressError(problem, field))
+		}
+		return errors.Join(qualified...)
+	}
+
+	qualify := func(name string) string {
+		switch name {
+		case "address":
+			return field
+		case "mode", "strictness", "min_confidence":
+			return name
+		}
+		return field + "." + name
+	}
+
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		qualified := *validationErr
+		qualified.Field = qualify(qualified.Field)
+		return &qualified
+	}
+
+	var parsingErr *domainerrors.ParsingError
+	if errors.As(err, &parsingErr) {
+		qualified := *parsingErr
+		qualified.Field = qualify(qualified.Field)
+		return &qualified
+	}
+
+	return err
+}
+
+// compareAddresses compares each component in its canonical form.
+func compareAddresses(a, b *dto.AddressDTO) map[string]*dto.ComponentComparisonDTO {
+	lineA := entity.ParseStreetLine(a.StreetAddress)
+	lineB := entity.ParseStreetLine(b.StreetAddress)
+
+	return map[string]*dto.ComponentComparisonDTO{
+		compareStreetNumber:        compareComponent(lineA.Number, lineB.Number),
+		compareStreetName:          compareComponent(lineA.Street(), lineB.Street()),
+		compareUnit:                compareComponent(lineA.Unit, lineB.Unit),
+		entity.ComponentCity:       compareComponent(entity.CanonicalCity(a.City), entity.CanonicalCity(b.City)),
+		entity.ComponentState:      compareComponent(strings.ToUpper(a.State), strings.ToUpper(b.State)),
+		entity.ComponentPostalCode: comparePostalCode(a.PostalCode, b.PostalCode),
+	}
+}
+
+func compareComponent(a, b string) *dto.ComponentComparisonDTO {
+	out := &dto.ComponentComparisonDTO{A: a, B: b}
+	switch {
+	case a == b:
+		out.Result, out.Similarity = dto.ComponentMatch, 1
+	case a == "" || b == "":
+		out.Result = dto.ComponentMissing
+	default:
+		out.Result, out.Similarity = dto.ComponentDifferent, similarity(a, b)
+	}
+	return out
+}
+
+// comparePostalCode matches on the 5-digit ZIP; the +4 narrows delivery within it.
+func comparePostalCode(a, b string) *dto.ComponentComparisonDTO {
+	out := compareComponent(zip5(a), zip5(b))
+	out.A, out.B = a, b
+	return out
+}
+
+func zip5(zip string) string {
+	if len(zip) > 5 {
+		return zip[:5]
+	}
+	return zip
+}
+
+// compareVerdict places the addresses on the exact > same_building > same_street > different scale.
+// The locality must agree first: by 5-digit ZIP, or by city and state when a ZIP is missing.
+func compareVerdict(components map[string]*dto.ComponentComparisonDTO) string {
+	matches := func(name string) bool { return components[name].Result == dto.ComponentMatch }
+	bothPresent := func(name string) bool {
+		c := components[name]
+		return c.A != "" && c.B != ""
+	}
+
+	locality := false
+	switch {
+	case bothPresent(entity.ComponentPostalCode):
+		locality = matches(entity.ComponentPostalCode)
+	case bothPresent(entity.ComponentCity) && bothPresent(entity.ComponentState):
+		locality = matches(entity.ComponentCity) && matches(entity.ComponentState)
+	}
+
+	if !locality || !bothPresent(compareStreetName) || !matches(compareStreetName) {
+		return dto.MatchDifferent
+	}
+	if !bothPresent(compareStreetNumber) || !matches(compareStreetNumber) {
+		return dto.MatchSameStreet
+	}
+	if !matches(compareUnit) {
+		return dto.MatchSameBuilding
+	}
+	// A differing city under the same ZIP is usually an alternate city name, so it
+	// lowers the similarity but not the verdict.
+	return dto.MatchExact
+}
+
+// compareSimilarity is the weighted mean of the component similarities. Components
+// neither address has are left out, and one an address lacks counts as half alike.
+func compareSimilarity(components map[string]*dto.ComponentComparisonDTO) float64 {
+	var total, weights float64
+	for name, c := range components {
+		if c.A == "" && c.B == "" {
+			continue
+		}
+		score := c.Similarity
+		if c.Result == dto.ComponentMissing {
+			score = 0.5
+		}
+		total += compareWeights[name] * score
+		weights += compareWeights[name]
+	}
+	if weights == 0 {
+		return 0
+	}
+	return math.Round(total/weights*100) / 100
+}
+
+// similarity is 1 minus the Levenshtein distance over the longer length.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return math.Round((1-float64(prev[len(rb)])/float64(longest))*100) / 100
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: CompareAddressesUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=compare_addresses_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase CompareAddressesUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompareAddressesUsecaseInterface is a mock of CompareAddressesUsecaseInterface interface.
+type MockCompareAddressesUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompareAddressesUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCompareAddressesUsecaseInterfaceMockRecorder is the mock recorder for MockCompareAddressesUsecaseInterface.
+type MockCompareAddressesUsecaseInterfaceMockRecorder struct {
+	mock *MockCompareAddressesUsecaseInterface
+}
+
+// NewMockCompareAddressesUsecaseInterface creates a new mock instance.
+func NewMockCompareAddressesUsecaseInterface(ctrl *gomock.Controller) *MockCompareAddressesUsecaseInterface {
+	mock := &MockCompareAddressesUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCompareAddressesUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompareAddressesUsecaseInterface) EXPECT() *MockCompareAddressesUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCompareAddressesUsecaseInterface) Execute(ctx context.Context, input *dto.CompareRequest) (*dto.CompareResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*dto.CompareResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCompareAddressesUsecaseInterfaceMockRecorder) Execute(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCompareAddressesUsecaseInterface)(nil).Execute), ctx, input)
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// addressValidator answers each address with the normalized address mapped to it.
+func addressValidator(ctrl *gomock.Controller, addresses map[string]*dto.AddressDTO) *MockValidateAddressUsecaseInterface {
+	validator := NewMockValidateAddressUsecaseInterface(ctrl)
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			return &dto.ValidateResponse{Success: true, Status: dto.StatusValid, Address: addresses[input.Address]}, nil
+		}).AnyTimes()
+	return validator
+}
+
+func TestCompareAddressesUsecase_Execute(t *testing.T) {
+	addresses := map[string]*dto.AddressDTO{
+		"main":          {StreetAddress: "123 North Main Street", City: "Saint Louis", State: "MO", PostalCode: "63101"},
+		"main abbr":     {StreetAddress: "123 N MAIN ST", City: "St. Louis", State: "mo", PostalCode: "63101-1234"},
+		"main apt 4":    {StreetAddress: "123 N Main St Apt 4", City: "St Louis", State: "MO", PostalCode: "63101"},
+		"main #5":       {StreetAddress: "123 N Main St #5", City: "St Louis", State: "MO", PostalCode: "63101"},
+		"main unit 4":   {StreetAddress: "123 N Main St Unit 4", City: "St Louis", State: "MO", PostalCode: "63101"},
+		"main 125":      {StreetAddress: "125 N Main St", City: "St Louis", State: "MO", PostalCode: "63101"},
+		"main no zip":   {StreetAddress: "123 N Main St", City: "St Louis", State: "MO"},
+		"main alt city": {StreetAddress: "123 N Main St", City: "Downtown", State: "MO", PostalCode: "63101"},
+		"main other":    {StreetAddress: "123 N Main St", City: "Springfield", State: "IL", PostalCode: "62701"},
+		"oak":           {StreetAddress: "123 N Oak St", City: "St Louis", State: "MO", PostalCode: "63101"},
+	}
+
+	tests := []struct {
+		name             string
+		a, b             string
+		expectVerdict    string
+		expectSimilarity float64
+	}{
+		{"abbreviation and casing differences", "main", "main abbr", dto.MatchExact, 1},
+		{"unit designators do not matter", "main apt 4", "main unit 4", dto.MatchExact, 1},
+		{"different units", "main apt 4", "main #5", dto.MatchSameBuilding, 0.9},
+		{"unit on one side only", "main", "main apt 4", dto.MatchSameBuilding, 0.95},
+		{"different house numbers", "main", "main 125", dto.MatchSameStreet, 0.91},
+		{"city and state without a ZIP", "main", "main no zip", dto.MatchExact, 0.89},
+		{"alternate city name under the same ZIP", "main", "main alt city", dto.MatchExact, 0.89},
+		{"same street elsewhere", "main", "main other", dto.MatchDifferent, 0.76},
+		{"different streets", "main", "oak", dto.MatchDifferent, 0.89},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewCompareAddressesUsecase(addressValidator(gomock.NewController(t), addresses))
+
+			resp, err := uc.Execute(context.Background(), &dto.CompareRequest{AddressA: tt.a, AddressB: tt.b})
+
+			require.NoError(t, err)
+			assert.True(t, resp.Success)
+			assert.Equal(t, tt.expectVerdict, resp.Verdict)
+			assert.Equal(t, tt.expectSimilarity, resp.Similarity)
+			assert.Equal(t, addresses[tt.a], resp.AddressA)
+		})
+	}
+}
+
+func TestCompareAddressesUsecase_Execute_Components(t *testing.T) {
+	uc := NewCompareAddressesUsecase(addressValidator(gomock.NewController(t), map[string]*dto.AddressDTO{
+		"a": {StreetAddress: "123 North Main Street Apt 4", City: "Saint Louis", State: "MO", PostalCode: "63101"},
+		"b": {StreetAddress: "123 N Main St", City: "St Louis", State: "MO", PostalCode: "63101-1234"},
+	}))
+
+	resp, err := uc.Execute(context.Background(), &dto.CompareRequest{AddressA: "a", AddressB: "b"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]*dto.ComponentComparisonDTO{
+		"street_number": {A: "123", B: "123", Result: "match", Similarity: 1},
+		"street_name":   {A: "N MAIN ST", B: "N MAIN ST", Result: "match", Similarity: 1},
+		"unit":          {A: "4", B: "", Result: "missing"},
+		"city":          {A: "ST LOUIS", B: "ST LOUIS", Result: "match", Similarity: 1},
+		"state":         {A: "MO", B: "MO", Result: "match", Similarity: 1},
+		"postal_code":   {A: "63101", B: "63101-1234", Result: "match", Similarity: 1},
+	}, resp.Components)
+}
+
+func TestCompareAddressesUsecase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectField string
+	}{
+		{"empty address", &domainerrors.ValidationError{Field: "address", Reason: "address field is required and cannot be empty"}, "address_b"},
+		{"component error", &domainerrors.ParsingError{Field: "state", Reason: "Unknown state code"}, "address_b.state"},
+		{"option error", &domainerrors.ValidationError{Field: "mode", Reason: "unknown mode"}, "mode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+			gomock.InOrder(
+				validator.EXPECT().Execute(gomock.Any(), gomock.Any()).
+					Return(&dto.ValidateResponse{Success: true, Address: &dto.AddressDTO{StreetAddress: "123 Main St"}}, nil),
+				validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, tt.err),
+			)
+
+			_, err := NewCompareAddressesUsecase(validator).Execute(context.Background(), &dto.CompareRequest{AddressA: "a", AddressB: "b"})
+
+			switch expected := tt.err.(type) {
+			case *domainerrors.ValidationError:
+				var ve *domainerrors.ValidationError
+				require.ErrorAs(t, err, &ve)
+				assert.Equal(t, tt.expectField, ve.Field)
+				assert.Equal(t, expected.Reason, ve.Reason)
+			case *domainerrors.ParsingError:
+				var pe *domainerrors.ParsingError
+				require.ErrorAs(t, err, &pe)
+				assert.Equal(t, tt.expectField, pe.Field)
+			}
+		})
+	}
+}
+
+func TestCompareAddressesUsecase_Execute_JoinedErrors(t *testing.T) {
+	validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.Join(
+		&domainerrors.ParsingError{Code: domainerrors.CodeStateInvalid, Field: "address", Reason: "invalid state code: XX"},
+		&domainerrors.ParsingError{Code: domainerrors.CodeZIPFormat, Field: "address", Reason: "postal_code must be 5 or 9-digit format"},
+	))
+
+	_, err := NewCompareAddressesUsecase(validator).Execute(context.Background(), &dto.CompareRequest{AddressA: "a", AddressB: "b"})
+
+	problems := domainerrors.Problems(err)
+	require.Len(t, problems, 2)
+	for _, problem := range problems {
+		var pe *domainerrors.ParsingError
+		require.ErrorAs(t, problem, &pe)
+		assert.Equal(t, "address_a", pe.Field)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, similarity("", ""))
+	assert.Equal(t, 0.8, similarity("63101", "63102"))
+	assert.Equal(t, 0.0, similarity("abc", "xyz"))
+	assert.Equal(t, 0.8, similarity("MAIN", "MAINE"))
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+const maxExtractTextLength = 50000
+
+//go:generate mockgen -destination=extract_addresses_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ExtractAddressesUsecaseInterface
+type ExtractAddressesUsecaseInterface interface {
+	Execute(ctx context.Context, input *dto.ExtractRequest) (*dto.ExtractResponse, error)
+}
+
+// ExtractAddressesUsecase finds addresses in unstructured text and normalizes each one.
+type ExtractAddressesUsecase struct {
+	finder    AddressSpanFinder
+	validator ValidateAddressUsecaseInterface
+}
+
+// NewExtractAddressesUsecase creates a new ExtractAddressesUsecase.
+func NewExtractAddressesUsecase(finder AddressSpanFinder, validator ValidateAddressUsecaseInterface) *ExtractAddressesUsecase {
+	return &ExtractAddressesUsecase{finder: finder, validator: validator}
+}
+
+// Execute scans the text for address spans and runs each through the validation pipeline.
+func (uc *ExtractAddressesUsecase) Execute(ctx context.Context, input *dto.ExtractRequest) (*dto.ExtractResponse, error) {
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldRequired,
+			Field:      "text",
+			Reason:     "text field is required and cannot be empty",
+			Suggestion: "Provide the text to scan for addresses",
+		}
+	}
+
+	if length := utf8.RuneCountInString(input.Text); length > maxExtractTextLength {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeLimitExceeded,
+			Field:      "text",
+			Reason:     "text exceeds the maximum length",
+			Value:      length,
+			Suggestion: "Split the text into chunks of at most 50000 characters",
+		}
+	}
+
+	spans := uc.finder.FindAddressSpans(input.Text)
+
+	resp := &dto.ExtractResponse{
+		Success:   true,
+		Addresses: make([]*dto.ExtractedAddressDTO, 0, len(spans)),
+	}
+
+	for _, span := range spans {
+		// Addresses in prose often wrap across lines; the parser expects a single line.
+		result, err := uc.validator.Execute(ctx, &dto.ValidateRequest{
+			Address: strings.Join(strings.Fields(span.Text), " "),
+		})
+		if err != nil {
+			result = dto.NewErrorResponse(err)
+		}
+
+		resp.Addresses = append(resp.Addresses, &dto.ExtractedAddressDTO{
+			Text:       span.Text,
+			Start:      span.Start,
+			End:        span.End,
+			Confidence: extractionConfidence(result),
+			Result:     result,
+		})
+	}
+
+	switch len(resp.Addresses) {
+	case 0:
+		resp.Message = "No addresses found"
+	case 1:
+		resp.Message = "Found 1 address"
+	default:
+		resp.Message = fmt.Sprintf("Found %d addresses", len(resp.Addresses))
+	}
+
+	return resp, nil
+}
+
+// extractionConfidence reports the overall score of a successfully normalized span.
+func extractionConfidence(result *dto.ValidateResponse) float64 {
+	if result == nil || !result.Success || result.Confidence == nil {
+		return 0
+	}
+	return result.Confidence.Overall
+}
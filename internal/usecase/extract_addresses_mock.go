@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: ExtractAddressesUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=extract_addresses_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ExtractAddressesUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExtractAddressesUsecaseInterface is a mock of ExtractAddressesUsecaseInterface interface.
+type MockExtractAddressesUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtractAddressesUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockExtractAddressesUsecaseInterfaceMockRecorder is the mock recorder for MockExtractAddressesUsecaseInterface.
+type MockExtractAddressesUsecaseInterfaceMockRecorder struct {
+	mock *MockExtractAddressesUsecaseInterface
+}
+
+// NewMockExtractAddressesUsecaseInterface creates a new mock instance.
+func NewMockExtractAddressesUsecaseInterface(ctrl *gomock.Controller) *MockExtractAddressesUsecaseInterface {
+	mock := &MockExtractAddressesUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockExtractAddressesUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtractAddressesUsecaseInterface) EXPECT() *MockExtractAddressesUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockExtractAddressesUsecaseInterface) Execute(ctx context.Context, input *dto.ExtractRequest) (*dto.ExtractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*dto.ExtractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockExtractAddressesUsecaseInterfaceMockRecorder) Execute(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExtractAddressesUsecaseInterface)(nil).Execute), ctx, input)
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+type mockSpanFinder struct {
+	spans []entity.TextSpan
+}
+
+func (m *mockSpanFinder) FindAddressSpans(_ string) []entity.TextSpan {
+	return m.spans
+}
+
+func TestExtractAddressesUsecase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      *dto.ExtractRequest
+		spans      []entity.TextSpan
+		setupMocks func(*MockValidateAddressUsecaseInterface)
+		expectErr  bool
+		checkResp  func(t *testing.T, resp *dto.ExtractResponse)
+	}{
+		{
+			name:      "empty text returns validation error",
+			input:     &dto.ExtractRequest{Text: "  "},
+			expectErr: true,
+		},
+		{
+			name:  "no spans found",
+			input: &dto.ExtractRequest{Text: "nothing to see here"},
+			checkResp: func(t *testing.T, resp *dto.ExtractResponse) {
+				assert.True(t, resp.Success)
+				assert.Empty(t, resp.Addresses)
+				assert.Equal(t, "No addresses found", resp.Message)
+			},
+		},
+		{
+			name:  "each span is validated with offsets preserved",
+			input: &dto.ExtractRequest{Text: "Ship to 123 Main St\nSpringfield, IL 62701 or to somewhere, XX"},
+			spans: []entity.TextSpan{
+				{Text: "123 Main St\nSpringfield, IL 62701", Start: 8, End: 41},
+				{Text: "somewhere, XX", Start: 48, End: 61},
+			},
+			setupMocks: func(m *MockValidateAddressUsecaseInterface) {
+				m.EXPECT().
+					Execute(gomock.Any(), &dto.ValidateRequest{Address: "123 Main St Springfield, IL 62701"}).
+					Return(&dto.ValidateResponse{
+						Success: true,
+						Address: &dto.AddressDTO{
+							StreetAddress: "123 Main St",
+							City:          "Springfield",
+							State:         "IL",
+							PostalCode:    "62701",
+						},
+						Confidence: &dto.ConfidenceDTO{Overall: 0.95},
+					}, nil)
+				m.EXPECT().
+					Execute(gomock.Any(), &dto.ValidateRequest{Address: "somewhere, XX"}).
+					Return(nil, &domainerrors.ParsingError{Field: "address", Reason: "invalid state code: XX"})
+			},
+			checkResp: func(t *testing.T, resp *dto.ExtractResponse) {
+				require.Len(t, resp.Addresses, 2)
+				assert.Equal(t, "Found 2 addresses", resp.Message)
+
+				first := resp.Addresses[0]
+				assert.Equal(t, 8, first.Start)
+				assert.Equal(t, 41, first.End)
+				assert.InDelta(t, 0.95, first.Confidence, 0.001)
+				assert.True(t, first.Result.Success)
+
+				second := resp.Addresses[1]
+				assert.Zero(t, second.Confidence)
+				assert.False(t, second.Result.Success)
+				require.Len(t, second.Result.Errors, 1)
+				assert.Equal(t, "invalid state code: XX", second.Result.Errors[0].Reason)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			validator := NewMockValidateAddressUsecaseInterface(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(validator)
+			}
+
+			uc := NewExtractAddressesUsecase(&mockSpanFinder{spans: tt.spans}, validator)
+			resp, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.expectErr {
+				var ve *domainerrors.ValidationError
+				require.ErrorAs(t, err, &ve)
+				assert.Equal(t, "text", ve.Field)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.checkResp != nil {
+				tt.checkResp(t, resp)
+			}
+		})
+	}
+}
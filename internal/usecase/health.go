@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+)
+
+// defaultHealthCheckTimeout bounds each readiness check when HealthConfig leaves it unset.
+const defaultHealthCheckTimeout = 2 * time.Second
+
+// Retry policy of ParserWarmup when ParserWarmupConfig leaves it unset.
+const (
+	defaultWarmupBaseBackoff = time.Second
+	defaultWarmupMaxBackoff  = time.Minute
+)
+
+// warmupAddress is parsed at startup so the parser's models are loaded before traffic.
+const warmupAddress = "1600 Pennsylvania Ave NW, Washington, DC 20500"
+
+// ErrWarmingUp is reported by the parser check until the first warm-up parse has finished.
+var ErrWarmingUp = errors.New("parser is warming up")
+
+//go:generate mockgen -destination=health_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase HealthUsecaseInterface
+type HealthUsecaseInterface interface {
+	Live(ctx context.Context) *dto.HealthResponse
+	Ready(ctx context.Context) *dto.ReadinessResponse
+}
+
+// HealthCheck probes one thing readiness depends on; Check returns nil when it is up.
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// HealthConfig describes what the health endpoints report.
+type HealthConfig struct {
+	// AppVersion is reported as the service version.
+	AppVersion string
+	// Versions names the versions of the parser, reference data and other
+	// components that shape results, keyed by component.
+	Versions map[string]string
+	// Checks must all pass for the service to be ready.
+	Checks []HealthCheck
+	// CheckTimeout bounds each check; zero uses two seconds.
+	CheckTimeout time.Duration
+}
+
+// HealthUsecase answers liveness and readiness probes.
+type HealthUsecase struct {
+	config HealthConfig
+	now    func() time.Time
+}
+
+// NewHealthUsecase creates a new HealthUsecase.
+func NewHealthUsecase(config HealthConfig) *HealthUsecase {
+	if config.CheckTimeout <= 0 {
+		config.CheckTimeout = defaultHealthCheckTimeout
+	}
+	return &HealthUsecase{config: config, now: time.Now}
+}
+
+// Live reports that the process is up. It checks nothing else, so a failing
+// dependency never gets the service restarted.
+func (uc *HealthUsecase) Live(_ context.Context) *dto.HealthResponse {
+	return &dto.HealthResponse{
+		Status:    dto.HealthStatusUp,
+		Version:   uc.config.AppVersion,
+		Timestamp: uc.now().UTC(),
+		Versions:  uc.config.Versions,
+	}
+}
+
+// Ready runs every check concurrently and reports the service ready only if all are up.
+func (uc *HealthUsecase) Ready(ctx context.Context) *dto.ReadinessResponse {
+	checks := make([]*dto.HealthCheckDTO, len(uc.config.Checks))
+
+	var wg sync.WaitGroup
+	for i, check := range uc.config.Checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checks[i] = uc.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	resp := &dto.ReadinessResponse{
+		Ready:     true,
+		Status:    dto.HealthStatusUp,
+		Version:   uc.config.AppVersion,
+		Timestamp: uc.now().UTC(),
+		Versions:  uc.config.Versions,
+		Checks:    make(map[string]*dto.HealthCheckDTO, len(checks)),
+	}
+	for i, check := range uc.config.Checks {
+		resp.Checks[check.Name] = checks[i]
+		if checks[i].Status != dto.HealthStatusUp {
+			resp.Ready = false
+			resp.Status = dto.HealthStatusDown
+		}
+	}
+	return resp
+}
+
+func (uc *HealthUsecase) run(ctx context.Context, check HealthCheck) *dto.HealthCheckDTO {
+	ctx, cancel := context.WithTimeout(ctx, uc.config.CheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Check(ctx)
+	result := &dto.HealthCheckDTO{Status: dto.HealthStatusUp, LatencyMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = dto.HealthStatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// ParserWarmupConfig is the retry policy of a ParserWarmup.
+type ParserWarmupConfig struct {
+	// BaseBackoff is the wait after the first failed parse; it doubles per attempt up to
+	// MaxBackoff. Zero values use one second and one minute.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// OnFailure, if set, is called after each failed parse with the wait before the next.
+	OnFailure func(err error, retryIn time.Duration)
+}
+
+// ParserWarmup parses a sample address through the parser until it succeeds, so that
+// readiness waits for the parser's models to load instead of the first request paying for it.
+type ParserWarmup struct {
+	repo   ValidateAddressRepository
+	config ParserWarmupConfig
+	wait   func(ctx context.Context, d time.Duration) error
+
+	mu   sync.RWMutex
+	done bool
+	err  error
+}
+
+// NewParserWarmup creates a ParserWarmup for repo; call Run to warm it up.
+func NewParserWarmup(repo ValidateAddressRepository, config ParserWarmupConfig) *ParserWarmup {
+	if config.BaseBackoff <= 0 {
+		config.BaseBackoff = defaultWarmupBaseBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaultWarmupMaxBackoff
+	}
+	return &ParserWarmup{repo: repo, config: config, wait: sleepContext}
+}
+
+// Run parses the sample address, retrying with exponential backoff until a parse
+// succeeds or ctx is done, and records each outcome for Check. It returns nil once the
+// parser is warm, or ctx's error together with the last failure.
+func (w *ParserWarmup) Run(ctx context.Context) error {
+	backoff := w.config.BaseBackoff
+	for {
+		_, _, err := w.repo.ParseAddress(ctx, warmupAddress)
+
+		w.mu.Lock()
+		w.done, w.err = err == nil, err
+		w.mu.Unlock()
+		if err == nil {
+			return nil
+		}
+
+		if w.config.OnFailure != nil {
+			w.config.OnFailure(err, backoff)
+		}
+		if waitErr := w.wait(ctx, backoff); waitErr != nil {
+			return errors.Join(waitErr, err)
+		}
+		backoff = min(2*backoff, w.config.MaxBackoff)
+	}
+}
+
+// Check returns nil once Run has parsed the sample address, the last failure while Run
+// is retrying, and ErrWarmingUp before the first attempt has finished.
+func (w *ParserWarmup) Check(_ context.Context) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	switch {
+	case w.done:
+		return nil
+	case w.err != nil:
+		return w.err
+	default:
+		return ErrWarmingUp
+	}
+}
+
+// sleepContext waits for d, returning early with ctx's error if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: HealthUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=health_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase HealthUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHealthUsecaseInterface is a mock of HealthUsecaseInterface interface.
+type MockHealthUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthUsecaseInterfaceMockRecorder is the mock recorder for MockHealthUsecaseInterface.
+type MockHealthUsecaseInterfaceMockRecorder struct {
+	mock *MockHealthUsecaseInterface
+}
+
+// NewMockHealthUsecaseInterface creates a new mock instance.
+func NewMockHealthUsecaseInterface(ctrl *gomock.Controller) *MockHealthUsecaseInterface {
+	mock := &MockHealthUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockHealthUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthUsecaseInterface) EXPECT() *MockHealthUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Live mocks base method.
+func (m *MockHealthUsecaseInterface) Live(ctx context.Context) *dto.HealthResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Live", ctx)
+	ret0, _ := ret[0].(*dto.HealthResponse)
+	return ret0
+}
+
+// Live indicates an expected call of Live.
+func (mr *MockHealthUsecaseInterfaceMockRecorder) Live(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Live", reflect.TypeOf((*MockHealthUsecaseInterface)(nil).Live), ctx)
+}
+
+// Ready mocks base method.
+func (m *MockHealthUsecaseInterface) Ready(ctx context.Context) *dto.ReadinessResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx)
+	ret0, _ := ret[0].(*dto.ReadinessResponse)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockHealthUsecaseInterfaceMockRecorder) Ready(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockHealthUsecaseInterface)(nil).Ready), ctx)
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+func upCheck(context.Context) error { return nil }
+
+func TestHealthUsecase_Live(t *testing.T) {
+	uc := NewHealthUsecase(HealthConfig{
+		AppVersion: "1.2.3",
+		Versions:   map[string]string{"parser": "regex/1"},
+		Checks:     []HealthCheck{{Name: "parser", Check: func(context.Context) error { return ErrWarmingUp }}},
+	})
+
+	resp := uc.Live(context.Background())
+
+	assert.Equal(t, dto.HealthStatusUp, resp.Status)
+	assert.Equal(t, "1.2.3", resp.Version)
+	assert.Equal(t, "regex/1", resp.Versions["parser"])
+	assert.False(t, resp.Timestamp.IsZero())
+}
+
+func TestHealthUsecase_Ready(t *testing.T) {
+	tests := []struct {
+		name        string
+		checks      []HealthCheck
+		expectReady bool
+		expectDown  map[string]string
+	}{
+		{
+			name:        "all checks up",
+			checks:      []HealthCheck{{Name: "parser", Check: upCheck}, {Name: "reference_data", Check: upCheck}},
+			expectReady: true,
+		},
+		{
+			name:       "one check down",
+			checks:     []HealthCheck{{Name: "parser", Check: upCheck}, {Name: "cache", Check: func(context.Context) error { return errors.New("connection refused") }}},
+			expectDown: map[string]string{"cache": "connection refused"},
+		},
+		{
+			name: "check exceeding the timeout",
+			checks: []HealthCheck{{Name: "database", Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}}},
+			expectDown: map[string]string{"database": context.DeadlineExceeded.Error()},
+		},
+		{
+			name:        "no checks",
+			expectReady: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewHealthUsecase(HealthConfig{AppVersion: "1.2.3", Checks: tt.checks, CheckTimeout: 10 * time.Millisecond})
+
+			resp := uc.Ready(context.Background())
+
+			assert.Equal(t, tt.expectReady, resp.Ready)
+			if tt.expectReady {
+				assert.Equal(t, dto.HealthStatusUp, resp.Status)
+			} else {
+				assert.Equal(t, dto.HealthStatusDown, resp.Status)
+			}
+			require.Len(t, resp.Checks, len(tt.checks))
+			for _, check := range tt.checks {
+				result := resp.Checks[check.Name]
+				if reason, down := tt.expectDown[check.Name]; down {
+					assert.Equal(t, dto.HealthStatusDown, result.Status, check.Name)
+					assert.Equal(t, reason, result.Error, check.Name)
+				} else {
+					assert.Equal(t, dto.HealthStatusUp, result.Status, check.Name)
+					assert.Empty(t, result.Error, check.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestParserWarmup(t *testing.T) {
+	t.Run("warming up until the sample address is parsed", func(t *testing.T) {
+		var parsed string
+		warmup := NewParserWarmup(&mockRepo{parseFn: func(_ context.Context, raw string) (*entity.Address, []*entity.Address, error) {
+			parsed = raw
+			return &entity.Address{City: "Washington"}, nil, nil
+		}}, ParserWarmupConfig{})
+
+		assert.ErrorIs(t, warmup.Check(context.Background()), ErrWarmingUp)
+
+		require.NoError(t, warmup.Run(context.Background()))
+		assert.Equal(t, warmupAddress, parsed)
+		assert.NoError(t, warmup.Check(context.Background()))
+	})
+
+	t.Run("failed parses are retried with backoff", func(t *testing.T) {
+		parseErr := errors.New("model not loaded")
+		attempts := 0
+		warmup := NewParserWarmup(&mockRepo{parseFn: func(context.Context, string) (*entity.Address, []*entity.Address, error) {
+			if attempts++; attempts < 5 {
+				return nil, nil, parseErr
+			}
+			return &entity.Address{}, nil, nil
+		}}, ParserWarmupConfig{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+		var waits []time.Duration
+		warmup.wait = func(ctx context.Context, d time.Duration) error {
+			assert.ErrorIs(t, warmup.Check(ctx), parseErr, "the check reports the last failure while retrying")
+			waits = append(waits, d)
+			return nil
+		}
+
+		require.NoError(t, warmup.Run(context.Background()))
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}, waits)
+		assert.NoError(t, warmup.Check(context.Background()))
+	})
+
+	t.Run("cancellation stops the retries", func(t *testing.T) {
+		parseErr := errors.New("model not loaded")
+		warmup := NewParserWarmup(&mockRepo{parseFn: func(context.Context, string) (*entity.Address, []*entity.Address, error) {
+			return nil, nil, parseErr
+		}}, ParserWarmupConfig{})
+
+		var failures int
+		warmup.config.OnFailure = func(error, time.Duration) { failures++ }
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := warmup.Run(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, err, parseErr)
+		assert.Equal(t, 1, failures)
+		assert.ErrorIs(t, warmup.Check(context.Background()), parseErr)
+	})
+}
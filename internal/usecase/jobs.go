@@ -0,0 +1,312 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+const (
+	// maxJobAddresses bounds a single submission; larger workloads are split into several jobs.
+	maxJobAddresses = 10000
+	// defaultJobPageSize and maxJobPageSize bound the results returned per status request.
+	defaultJobPageSize = 100
+	maxJobPageSize     = 1000
+)
+
+//go:generate mockgen -destination=jobs_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase JobsUsecaseInterface
+type JobsUsecaseInterface interface {
+	Submit(ctx context.Context, input *dto.CreateJobRequest) (*dto.JobResponse, error)
+	Get(ctx context.Context, id string, page, pageSize int) (*dto.JobResponse, error)
+}
+
+// JobsConfig holds configuration for JobsUsecase.
+type JobsConfig struct {
+	// DefaultStrictness must match the validator's so submissions are checked the
+	// same way; empty means standard.
+	DefaultStrictness entity.Strictness
+	// WebhookSecrets maps tenants to their signing secrets. Only tenants with a
+	// secret may ask for callbacks.
+	WebhookSecrets map[string]string
+}
+
+// JobsUsecase accepts batch validation jobs and reports their progress.
+// The jobs themselves are processed by RunJobsUsecase.
+type JobsUsecase struct {
+	repo   JobRepository
+	config JobsConfig
+	now    func() time.Time
+	lookup func(ctx context.Context, host string) ([]netip.Addr, error)
+}
+
+// NewJobsUsecase creates a new JobsUsecase.
+func NewJobsUsecase(repo JobRepository, config JobsConfig) *JobsUsecase {
+	if config.DefaultStrictness == "" {
+		config.DefaultStrictness = entity.StrictnessStandard
+	}
+	return &JobsUsecase{repo: repo, config: config, now: time.Now, lookup: lookupHost}
+}
+
+// lookupHost resolves host with the system resolver; an IP address resolves to itself.
+func lookupHost(ctx context.Context, host string) ([]netip.Addr, error) {
+	return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+}
+
+// Submit checks the job's options and queues its addresses for validation.
+func (uc *JobsUsecase) Submit(ctx context.Context, input *dto.CreateJobRequest) (*dto.JobResponse, error) {
+	if len(input.Addresses) == 0 {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldRequired,
+			Field:      "addresses",
+			Reason:     "addresses field is required and cannot be empty",
+			Suggestion: "Provide a list of addresses to validate",
+		}
+	}
+	if len(input.Addresses) > maxJobAddresses {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeLimitExceeded,
+			Field:      "addresses",
+			Reason:     "too many addresses in one job",
+			Value:      len(input.Addresses),
+			Suggestion: "Split the workload into jobs of at most 10000 addresses",
+		}
+	}
+
+	options := &dto.ValidateRequest{
+		IncludeComponents: input.IncludeComponents,
+		MinConfidence:     input.MinConfidence,
+		Mode:              input.Mode,
+		Strictness:        input.Strictness,
+		Format:            input.Format,
+		MaxLineLength:     input.MaxLineLength,
+	}
+	if _, err := resolveOptions(options, uc.config.DefaultStrictness); err != nil {
+		return nil, err
+	}
+
+	tenant := entity.TenantFromContext(ctx)
+	callback, err := uc.resolveCallback(ctx, tenant, input)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now().UTC()
+	job := &entity.Job{
+		ID:        id,
+		Status:    entity.JobQueued,
+		Tenant:    tenant,
+		Callback:  callback,
+		Progress:  entity.JobProgress{Total: len(input.Addresses)},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.repo.CreateJob(ctx, job, options, input.Addresses); err != nil {
+		return nil, err
+	}
+
+	return &dto.JobResponse{
+		Success: true,
+		Job:     mapJobToDTO(job),
+		Message: "Job queued",
+	}, nil
+}
+
+// Get returns a job's state and one page of its results, in submission order.
+func (uc *JobsUsecase) Get(ctx context.Context, id string, page, pageSize int) (*dto.JobResponse, error) {
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultJobPageSize
+	}
+	if page < 1 {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldOutOfRange,
+			Field:      "page",
+			Reason:     "page must be a positive integer",
+			Value:      page,
+			Suggestion: "Pages start at 1",
+		}
+	}
+	if pageSize < 1 || pageSize > maxJobPageSize {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldOutOfRange,
+			Field:      "page_size",
+			Reason:     "page_size must be between 1 and 1000",
+			Value:      pageSize,
+			Suggestion: "Omit page_size to use 100",
+		}
+	}
+
+	job, ok, err := uc.repo.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || job.Tenant != entity.TenantFromContext(ctx) {
+		return nil, &domainerrors.NotFoundError{Resource: "job", ID: id}
+	}
+
+	items, err := uc.repo.ListJobResults(ctx, id, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*dto.JobResultDTO, 0, len(items))
+	for _, item := range items {
+		results = append(results, mapJobResultToDTO(item))
+	}
+
+	return &dto.JobResponse{
+		Success: true,
+		Job:     mapJobToDTO(job),
+		Results: results,
+		Pagination: &dto.PaginationDTO{
+			Page:       page,
+			PageSize:   pageSize,
+			TotalPages: (job.Progress.Total + pageSize - 1) / pageSize,
+		},
+		Message: "Job " + string(job.Status),
+	}, nil
+}
+
+// resolveCallback checks the submission's callback settings. Events default to job.completed.
+func (uc *JobsUsecase) resolveCallback(ctx context.Context, tenant string, input *dto.CreateJobRequest) (*entity.JobCallback, error) {
+	if input.CallbackURL == "" {
+		if len(input.CallbackEvents) > 0 {
+			return nil, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeFieldRequired,
+				Field:      "callback_events",
+				Reason:     "callback_events requires callback_url",
+				Suggestion: "Provide callback_url or omit callback_events",
+			}
+		}
+		return nil, nil
+	}
+
+	target, err := url.Parse(input.CallbackURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      "callback_url",
+			Reason:     "callback_url must be an absolute http or https URL",
+			Value:      input.CallbackURL,
+			Suggestion: "Use a URL like https://example.com/hooks/jobs",
+		}
+	}
+	if _, ok := uc.config.WebhookSecrets[tenant]; !ok {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeWebhooksDisabled,
+			Field:      "callback_url",
+			Reason:     "webhooks are not enabled for this tenant",
+			Value:      tenant,
+			Suggestion: "Ask an operator to configure a webhook secret for the tenant",
+		}
+	}
+
+	callback := &entity.JobCallback{URL: input.CallbackURL, Events: []entity.WebhookEvent{entity.EventJobCompleted}}
+	if len(input.CallbackEvents) > 0 {
+		callback.Events = callback.Events[:0]
+		for _, name := range input.CallbackEvents {
+			event := entity.WebhookEvent(name)
+			if !event.IsValid() {
+				return nil, &domainerrors.ValidationError{
+					Code:       domainerrors.CodeFieldInvalid,
+					Field:      "callback_events",
+					Reason:     "unknown callback event",
+					Value:      name,
+					Suggestion: "Use job.completed or item.completed",
+				}
+			}
+			callback.Events = append(callback.Events, event)
+		}
+	}
+	if err := uc.checkCallbackHost(ctx, target.Hostname()); err != nil {
+		return nil, err
+	}
+	return callback, nil
+}
+
+// checkCallbackHost rejects a callback host that resolves to an address webhooks may not
+// reach, such as loopback or a private network. The sender checks again when it connects,
+// since the host may resolve differently by then.
+func (uc *JobsUsecase) checkCallbackHost(ctx context.Context, host string) error {
+	addrs, err := uc.lookup(ctx, host)
+	if err != nil {
+		return &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      "callback_url",
+			Reason:     "callback_url host could not be resolved",
+			Value:      host,
+			Suggestion: "Use a host name that resolves in public DNS",
+		}
+	}
+
+	for _, addr := range addrs {
+		if !entity.IsPublicCallbackAddr(addr) {
+			return &domainerrors.ValidationError{
+				Code:       domainerrors.CodeFieldInvalid,
+				Field:      "callback_url",
+				Reason:     "callback_url must resolve to a public address",
+				Value:      host,
+				Suggestion: "Use a publicly reachable host; loopback, private and link-local addresses are refused",
+			}
+		}
+	}
+	return nil
+}
+
+func newJobID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+func mapJobToDTO(job *entity.Job) *dto.JobDTO {
+	out := &dto.JobDTO{
+		ID:        job.ID,
+		Status:    string(job.Status),
+		Total:     job.Progress.Total,
+		Processed: job.Progress.Processed,
+		Succeeded: job.Progress.Succeeded,
+		Failed:    job.Progress.Failed,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+	if job.Callback != nil {
+		out.CallbackURL = job.Callback.URL
+	}
+	return out
+}
+
+func mapJobResultToDTO(item *JobItemResult) *dto.JobResultDTO {
+	out := &dto.JobResultDTO{
+		Index:   item.Item.Index,
+		Address: item.Item.Address,
+		Status:  string(item.Item.Status),
+	}
+
+	switch {
+	case item.Result == nil:
+	case item.Result.Error != nil:
+		out.Result = dto.NewErrorResponse(item.Result.Error.Err())
+	default:
+		out.Result = item.Result.Response
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: JobsUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=jobs_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase JobsUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobsUsecaseInterface is a mock of JobsUsecaseInterface interface.
+type MockJobsUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobsUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockJobsUsecaseInterfaceMockRecorder is the mock recorder for MockJobsUsecaseInterface.
+type MockJobsUsecaseInterfaceMockRecorder struct {
+	mock *MockJobsUsecaseInterface
+}
+
+// NewMockJobsUsecaseInterface creates a new mock instance.
+func NewMockJobsUsecaseInterface(ctrl *gomock.Controller) *MockJobsUsecaseInterface {
+	mock := &MockJobsUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockJobsUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobsUsecaseInterface) EXPECT() *MockJobsUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockJobsUsecaseInterface) Get(ctx context.Context, id string, page, pageSize int) (*dto.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, page, pageSize)
+	ret0, _ := ret[0].(*dto.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockJobsUsecaseInterfaceMockRecorder) Get(ctx, id, page, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockJobsUsecaseInterface)(nil).Get), ctx, id, page, pageSize)
+}
+
+// Submit mocks base method.
+func (m *MockJobsUsecaseInterface) Submit(ctx context.Context, input *dto.CreateJobRequest) (*dto.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, input)
+	ret0, _ := ret[0].(*dto.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockJobsUsecaseInterfaceMockRecorder) Submit(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockJobsUsecaseInterface)(nil).Submit), ctx, input)
+}
@@ -0,0 +1,529 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// fakeJobRepository keeps jobs in memory with the same semantics as the SQL store.
+type fakeJobRepository struct {
+	mu       sync.Mutex
+	jobs     map[string]*entity.Job
+	options  map[string]*dto.ValidateRequest
+	items    map[string][]*JobItemResult
+	notified []*entity.WebhookDelivery
+	failSave bool
+	// nextAttemptAt holds when each requeued job may be claimed; ClaimJob compares it to now.
+	nextAttemptAt map[string]time.Time
+	now           time.Time
+}
+
+func newFakeJobRepository() *fakeJobRepository {
+	return &fakeJobRepository{
+		jobs:          make(map[string]*entity.Job),
+		options:       make(map[string]*dto.ValidateRequest),
+		items:         make(map[string][]*JobItemResult),
+		nextAttemptAt: make(map[string]time.Time),
+	}
+}
+
+func (r *fakeJobRepository) CreateJob(_ context.Context, job *entity.Job, options *dto.ValidateRequest, addresses []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *job
+	r.jobs[job.ID] = &stored
+	r.options[job.ID] = options
+	for i, address := range addresses {
+		r.items[job.ID] = append(r.items[job.ID], &JobItemResult{
+			Item: entity.JobItem{Index: i, Address: address, Status: entity.JobItemPending},
+		})
+	}
+	return nil
+}
+
+func (r *fakeJobRepository) GetJob(_ context.Context, id string) (*entity.Job, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, false, nil
+	}
+
+	out := *job
+	out.Progress = entity.JobProgress{Total: len(r.items[id])}
+	for _, item := range r.items[id] {
+		switch item.Item.Status {
+		case entity.JobItemSucceeded:
+			out.Progress.Processed++
+			out.Progress.Succeeded++
+		case entity.JobItemFailed:
+			out.Progress.Processed++
+			out.Progress.Failed++
+		}
+	}
+	return &out, true, nil
+}
+
+func (r *fakeJobRepository) ListJobResults(_ context.Context, id string, offset, limit int) ([]*JobItemResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.items[id]
+	if offset >= len(items) {
+		return nil, nil
+	}
+	return items[offset:min(offset+limit, len(items))], nil
+}
+
+func (r *fakeJobRepository) ClaimJob(_ context.Context) (*entity.Job, *dto.ValidateRequest, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]string, 0, len(r.jobs))
+	for id, job := range r.jobs {
+		if job.Status == entity.JobQueued && !r.nextAttemptAt[id].After(r.now) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil, false, nil
+	}
+	sort.Strings(ids)
+
+	job := r.jobs[ids[0]]
+	job.Status = entity.JobRunning
+	out := *job
+	return &out, r.options[job.ID], true, nil
+}
+
+func (r *fakeJobRepository) PendingJobItems(_ context.Context, id string) ([]entity.JobItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pending []entity.JobItem
+	for _, item := range r.items[id] {
+		if item.Item.Status == entity.JobItemPending {
+			pending = append(pending, item.Item)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeJobRepository) SaveJobItemResult(_ context.Context, id string, index int, result *dto.ValidationJobResult, notify *entity.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.failSave {
+		return errors.New("disk full")
+	}
+
+	item := r.items[id][index]
+	item.Result = result
+	item.Item.Status = entity.JobItemSucceeded
+	if result.Error != nil {
+		item.Item.Status = entity.JobItemFailed
+	}
+	r.notify(notify)
+	return nil
+}
+
+func (r *fakeJobRepository) FinishJob(_ context.Context, id string, status entity.JobStatus, reason string, notify *entity.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.jobs[id].Status = status
+	r.jobs[id].Error = reason
+	r.notify(notify)
+	return nil
+}
+
+func (r *fakeJobRepository) notify(delivery *entity.WebhookDelivery) {
+	if delivery != nil {
+		r.notified = append(r.notified, delivery)
+	}
+}
+
+func (r *fakeJobRepository) RequeueJob(_ context.Context, id string, attempts int, nextAttemptAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if job := r.jobs[id]; job.Status == entity.JobRunning {
+		job.Status = entity.JobQueued
+		job.Attempts = attempts
+		r.nextAttemptAt[id] = nextAttemptAt
+	}
+	return nil
+}
+
+func (r *fakeJobRepository) RequeueRunningJobs(_ context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, job := range r.jobs {
+		if job.Status == entity.JobRunning {
+			job.Status = entity.JobQueued
+			n++
+		}
+	}
+	return n, nil
+}
+
+// publicLookup resolves IP literals to themselves and any other host to a public
+// address, so tests never touch DNS.
+func publicLookup(_ context.Context, host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+	if host == "unresolvable.example" {
+		return nil, errors.New("no such host")
+	}
+	if host == "internal.example.com" {
+		return []netip.Addr{netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.7")}, nil
+	}
+	return []netip.Addr{netip.MustParseAddr("93.184.216.34")}, nil
+}
+
+func TestJobsUsecase_Submit(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *dto.CreateJobRequest
+		tenant      string
+		expectField string
+	}{
+		{
+			name:        "empty address list",
+			input:       &dto.CreateJobRequest{},
+			expectField: "addresses",
+		},
+		{
+			name:        "too many addresses",
+			input:       &dto.CreateJobRequest{Addresses: make([]string, maxJobAddresses+1)},
+			expectField: "addresses",
+		},
+		{
+			name:        "invalid options are rejected up front",
+			input:       &dto.CreateJobRequest{Addresses: []string{"123 Main St"}, Mode: "zip"},
+			expectField: "mode",
+		},
+		{
+			name:        "callback url must be absolute http",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackURL: "ftp://example.com/hooks"},
+			expectField: "callback_url",
+		},
+		{
+			name:        "callback requires a tenant secret",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackURL: "https://example.com/hooks"},
+			tenant:      "unknown",
+			expectField: "callback_url",
+		},
+		{
+			name: "unknown callback event",
+			input: &dto.CreateJobRequest{
+				Addresses: []string{"a"}, CallbackURL: "https://example.com/hooks", CallbackEvents: []string{"job.started"},
+			},
+			expectField: "callback_events",
+		},
+		{
+			name:        "callback to loopback",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackURL: "http://127.0.0.1:8080/hooks"},
+			expectField: "callback_url",
+		},
+		{
+			name:        "callback to the metadata endpoint",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackURL: "http://169.254.169.254/latest/meta-data"},
+			expectField: "callback_url",
+		},
+		{
+			name:        "callback host resolving to a private address",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackURL: "https://internal.example.com/hooks"},
+			expectField: "callback_url",
+		},
+		{
+			name:        "callback host that does not resolve",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackURL: "https://unresolvable.example/hooks"},
+			expectField: "callback_url",
+		},
+		{
+			name:        "callback events without url",
+			input:       &dto.CreateJobRequest{Addresses: []string{"a"}, CallbackEvents: []string{"job.completed"}},
+			expectField: "callback_events",
+		},
+		{
+			name:  "valid submission is queued",
+			input: &dto.CreateJobRequest{Addresses: []string{"123 Main St, Springfield, IL", ""}, Strictness: "lenient"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeJobRepository()
+			uc := NewJobsUsecase(repo, JobsConfig{WebhookSecrets: map[string]string{entity.DefaultTenant: "s3cret"}})
+			uc.lookup = publicLookup
+
+			resp, err := uc.Submit(entity.ContextWithTenant(context.Background(), tt.tenant), tt.input)
+
+			if tt.expectField != "" {
+				var ve *domainerrors.ValidationError
+				require.ErrorAs(t, err, &ve)
+				assert.Equal(t, tt.expectField, ve.Field)
+				assert.Empty(t, repo.jobs)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, resp.Success)
+			assert.Equal(t, "queued", resp.Job.Status)
+			assert.Equal(t, 2, resp.Job.Total)
+			assert.Len(t, resp.Job.ID, 32)
+			assert.Equal(t, "lenient", repo.options[resp.Job.ID].Strictness)
+		})
+	}
+}
+
+func TestJobsUsecase_Get(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeJobRepository()
+	uc := NewJobsUsecase(repo, JobsConfig{})
+
+	submitted, err := uc.Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"a", "b", "c"}})
+	require.NoError(t, err)
+	id := submitted.Job.ID
+
+	require.NoError(t, repo.SaveJobItemResult(ctx, id, 0, &dto.ValidationJobResult{
+		Response: &dto.ValidateResponse{Success: true, Status: dto.StatusValid},
+	}, nil))
+	require.NoError(t, repo.SaveJobItemResult(ctx, id, 1, &dto.ValidationJobResult{
+		Error: dto.NewValidationJobError(&domainerrors.ParsingError{Field: "address", Reason: "could not parse"}),
+	}, nil))
+
+	t.Run("first page with progress", func(t *testing.T) {
+		resp, err := uc.Get(ctx, id, 1, 2)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, resp.Job.Total)
+		assert.Equal(t, 2, resp.Job.Processed)
+		assert.Equal(t, 1, resp.Job.Succeeded)
+		assert.Equal(t, 1, resp.Job.Failed)
+		assert.Equal(t, &dto.PaginationDTO{Page: 1, PageSize: 2, TotalPages: 2}, resp.Pagination)
+
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "succeeded", resp.Results[0].Status)
+		assert.Equal(t, dto.StatusValid, resp.Results[0].Result.Status)
+		assert.Equal(t, "failed", resp.Results[1].Status)
+		assert.False(t, resp.Results[1].Result.Success)
+		assert.Equal(t, "could not parse", resp.Results[1].Result.Errors[0].Reason)
+	})
+
+	t.Run("second page has the pending item", func(t *testing.T) {
+		resp, err := uc.Get(ctx, id, 2, 2)
+		require.NoError(t, err)
+
+		require.Len(t, resp.Results, 1)
+		assert.Equal(t, 2, resp.Results[0].Index)
+		assert.Equal(t, "pending", resp.Results[0].Status)
+		assert.Nil(t, resp.Results[0].Result)
+	})
+
+	t.Run("defaults apply to omitted pagination", func(t *testing.T) {
+		resp, err := uc.Get(ctx, id, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &dto.PaginationDTO{Page: 1, PageSize: defaultJobPageSize, TotalPages: 1}, resp.Pagination)
+	})
+
+	t.Run("invalid pagination", func(t *testing.T) {
+		_, err := uc.Get(ctx, id, 1, maxJobPageSize+1)
+		var ve *domainerrors.ValidationError
+		require.ErrorAs(t, err, &ve)
+		assert.Equal(t, "page_size", ve.Field)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		_, err := uc.Get(ctx, "missing", 1, 10)
+		var nf *domainerrors.NotFoundError
+		require.ErrorAs(t, err, &nf)
+	})
+
+	t.Run("another tenant's job", func(t *testing.T) {
+		_, err := uc.Get(entity.ContextWithTenant(ctx, "other"), id, 1, 10)
+		var nf *domainerrors.NotFoundError
+		require.ErrorAs(t, err, &nf)
+	})
+}
+
+func TestRunJobsUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no queued job", func(t *testing.T) {
+		claimed, err := NewRunJobsUsecase(newFakeJobRepository(), nil, nil, RunJobsConfig{}).Execute(ctx)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("validates every pending item with the job options", func(t *testing.T) {
+		repo := newFakeJobRepository()
+		submitted, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{
+			Addresses:  []string{"123 Main St, Springfield, IL 62701", ""},
+			Strictness: "lenient",
+		})
+		require.NoError(t, err)
+		id := submitted.Job.ID
+
+		validator := NewValidateAddressUsecase(&countingRepo{}, nil, ValidateAddressConfig{})
+		claimed, err := NewRunJobsUsecase(repo, validator, nil, RunJobsConfig{}).Execute(ctx)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		job, _, _ := repo.GetJob(ctx, id)
+		assert.Equal(t, entity.JobDone, job.Status)
+		assert.Equal(t, entity.JobProgress{Total: 2, Processed: 2, Succeeded: 1, Failed: 1}, job.Progress)
+
+		first := repo.items[id][0].Result.Response
+		require.NotNil(t, first)
+		assert.Equal(t, "lenient", first.Strictness, "job options are applied to each address")
+	})
+
+	t.Run("resumes a requeued job from its pending items", func(t *testing.T) {
+		repo := newFakeJobRepository()
+		submitted, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"a", "b"}})
+		require.NoError(t, err)
+		id := submitted.Job.ID
+
+		done := &dto.ValidationJobResult{Response: &dto.ValidateResponse{Success: true}}
+		require.NoError(t, repo.SaveJobItemResult(ctx, id, 0, done, nil))
+
+		repo.jobs[id].Status = entity.JobRunning
+		n, _ := repo.RequeueRunningJobs(ctx)
+		assert.Equal(t, 1, n)
+
+		repoCalls := &countingRepo{}
+		validator := NewValidateAddressUsecase(repoCalls, nil, ValidateAddressConfig{})
+		_, err = NewRunJobsUsecase(repo, validator, nil, RunJobsConfig{}).Execute(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, repoCalls.calls, "only the pending item is validated")
+		assert.Same(t, done, repo.items[id][0].Result)
+	})
+
+	t.Run("leaves items pending and requeues the job when validation cannot finish", func(t *testing.T) {
+		repo := newFakeJobRepository()
+		submitted, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"a", "b"}})
+		require.NoError(t, err)
+		id := submitted.Job.ID
+
+		ctrl := gomock.NewController(t)
+		validator := NewMockValidateAddressUsecaseInterface(ctrl)
+		validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, context.Canceled)
+
+		claimed, err := NewRunJobsUsecase(repo, validator, nil, RunJobsConfig{}).Execute(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.True(t, claimed)
+
+		job, _, _ := repo.GetJob(ctx, id)
+		assert.Equal(t, entity.JobQueued, job.Status)
+		assert.Zero(t, job.Progress.Processed)
+	})
+
+	t.Run("a job that keeps stopping backs off and then fails", func(t *testing.T) {
+		repo := newFakeJobRepository()
+		submitted, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"a"}})
+		require.NoError(t, err)
+		id := submitted.Job.ID
+
+		broken := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+		broken.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("parser unavailable")).Times(3)
+
+		start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		runner := NewRunJobsUsecase(repo, broken, nil, RunJobsConfig{MaxAttempts: 3, BaseBackoff: time.Second, MaxBackoff: time.Minute})
+		runner.now = func() time.Time { return repo.now }
+
+		repo.now = start
+		for attempt := 1; attempt <= 2; attempt++ {
+			claimed, err := runner.Execute(ctx)
+			require.True(t, claimed)
+			require.EqualError(t, err, "parser unavailable")
+
+			job, _, _ := repo.GetJob(ctx, id)
+			assert.Equal(t, entity.JobQueued, job.Status)
+			assert.Equal(t, attempt, job.Attempts)
+
+			claimed, err = runner.Execute(ctx)
+			require.NoError(t, err)
+			assert.False(t, claimed, "the job is not claimed again during its backoff")
+
+			repo.now = repo.now.Add(time.Minute)
+		}
+
+		claimed, err := runner.Execute(ctx)
+		require.True(t, claimed)
+		require.ErrorContains(t, err, "parser unavailable")
+
+		job, _, _ := repo.GetJob(ctx, id)
+		assert.Equal(t, entity.JobFailed, job.Status)
+		assert.Equal(t, "gave up after 3 attempts: parser unavailable", job.Error)
+	})
+
+	t.Run("a job in backoff does not hold up later jobs", func(t *testing.T) {
+		repo := newFakeJobRepository()
+		first, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"a"}})
+		require.NoError(t, err)
+		second, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"b"}})
+		require.NoError(t, err)
+		// The fake claims in id order; make sure the poison job comes first.
+		poison, healthy := first.Job.ID, second.Job.ID
+		if poison > healthy {
+			poison, healthy = healthy, poison
+		}
+
+		validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+		validator.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+				if input.Address == repo.items[poison][0].Item.Address {
+					return nil, errors.New("parser unavailable")
+				}
+				return &dto.ValidateResponse{Success: true}, nil
+			}).Times(2)
+
+		runner := NewRunJobsUsecase(repo, validator, nil, RunJobsConfig{})
+		runner.now = func() time.Time { return repo.now }
+
+		_, err = runner.Execute(ctx)
+		require.Error(t, err)
+		_, err = runner.Execute(ctx)
+		require.NoError(t, err)
+
+		job, _, _ := repo.GetJob(ctx, healthy)
+		assert.Equal(t, entity.JobDone, job.Status)
+	})
+
+	t.Run("store failure fails the job", func(t *testing.T) {
+		repo := newFakeJobRepository()
+		submitted, err := NewJobsUsecase(repo, JobsConfig{}).Submit(ctx, &dto.CreateJobRequest{Addresses: []string{"a"}})
+		require.NoError(t, err)
+		repo.failSave = true
+
+		validator := NewValidateAddressUsecase(&countingRepo{}, nil, ValidateAddressConfig{})
+		claimed, err := NewRunJobsUsecase(repo, validator, nil, RunJobsConfig{}).Execute(ctx)
+		require.Error(t, err)
+		assert.True(t, claimed)
+
+		job, _, _ := repo.GetJob(ctx, submitted.Job.ID)
+		assert.Equal(t, entity.JobFailed, job.Status)
+		assert.Equal(t, "disk full", job.Error)
+	})
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+//go:generate mockgen -destination=parse_address_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ParseAddressUsecaseInterface
+type ParseAddressUsecaseInterface interface {
+	Execute(ctx context.Context, input *dto.ParseRequest) (*dto.ParseResponse, error)
+}
+
+// ParseAddressUsecase runs only the parser, for debugging normalizations: it reports
+// the parser's raw labels next to the components they normalize to, without the
+// result cache, reference data checks or scoring that validation adds.
+type ParseAddressUsecase struct {
+	parser AddressLabelParser
+}
+
+// NewParseAddressUsecase creates a new ParseAddressUsecase.
+func NewParseAddressUsecase(parser AddressLabelParser) *ParseAddressUsecase {
+	return &ParseAddressUsecase{parser: parser}
+}
+
+// Execute parses input.Address and returns the parser's trace.
+func (uc *ParseAddressUsecase) Execute(ctx context.Context, input *dto.ParseRequest) (*dto.ParseResponse, error) {
+	if strings.TrimSpace(input.Address) == "" {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeAddressEmpty,
+			Field:      "address",
+			Reason:     "address field is required and cannot be empty",
+			Suggestion: "Provide the address to parse",
+		}
+	}
+
+	trace, err := uc.parser.ParseLabels(ctx, input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]*dto.ParseLabelDTO, 0, len(trace.Labels))
+	for _, label := range trace.Labels {
+		labels = append(labels, &dto.ParseLabelDTO{Label: label.Label, Value: label.Value})
+	}
+
+	return &dto.ParseResponse{
+		Success:    true,
+		Parser:     trace.Parser,
+		Labels:     labels,
+		Normalized: trace.Normalized,
+		Message:    fmt.Sprintf("Parser produced %d labels", len(labels)),
+	}, nil
+}
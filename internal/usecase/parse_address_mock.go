@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: ParseAddressUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=parse_address_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ParseAddressUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockParseAddressUsecaseInterface is a mock of ParseAddressUsecaseInterface interface.
+type MockParseAddressUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockParseAddressUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockParseAddressUsecaseInterfaceMockRecorder is the mock recorder for MockParseAddressUsecaseInterface.
+type MockParseAddressUsecaseInterfaceMockRecorder struct {
+	mock *MockParseAddressUsecaseInterface
+}
+
+// NewMockParseAddressUsecaseInterface creates a new mock instance.
+func NewMockParseAddressUsecaseInterface(ctrl *gomock.Controller) *MockParseAddressUsecaseInterface {
+	mock := &MockParseAddressUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockParseAddressUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParseAddressUsecaseInterface) EXPECT() *MockParseAddressUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockParseAddressUsecaseInterface) Execute(ctx context.Context, input *dto.ParseRequest) (*dto.ParseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*dto.ParseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockParseAddressUsecaseInterfaceMockRecorder) Execute(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockParseAddressUsecaseInterface)(nil).Execute), ctx, input)
+}
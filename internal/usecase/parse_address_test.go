@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+type fakeLabelParser struct {
+	trace *entity.ParseTrace
+	err   error
+	calls int
+}
+
+func (p *fakeLabelParser) ParseLabels(_ context.Context, _ string) (*entity.ParseTrace, error) {
+	p.calls++
+	return p.trace, p.err
+}
+
+func TestParseAddressUsecase_Execute(t *testing.T) {
+	t.Run("returns labels and normalized components", func(t *testing.T) {
+		parser := &fakeLabelParser{trace: &entity.ParseTrace{
+			Parser: "gopostal/1",
+			Labels: []entity.ParseLabel{
+				{Label: "house_number", Value: "123"},
+				{Label: "road", Value: "main st"},
+				{Label: "city", Value: "springfield"},
+			},
+			Normalized: map[string]string{entity.ComponentStreetAddress: "123 Main St", entity.ComponentCity: "Springfield"},
+		}}
+
+		resp, err := NewParseAddressUsecase(parser).Execute(context.Background(), &dto.ParseRequest{Address: "123 main st springfield"})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.Equal(t, "gopostal/1", resp.Parser)
+		assert.Equal(t, []*dto.ParseLabelDTO{
+			{Label: "house_number", Value: "123"},
+			{Label: "road", Value: "main st"},
+			{Label: "city", Value: "springfield"},
+		}, resp.Labels)
+		assert.Equal(t, "123 Main St", resp.Normalized[entity.ComponentStreetAddress])
+		assert.Equal(t, "Parser produced 3 labels", resp.Message)
+	})
+
+	t.Run("empty address", func(t *testing.T) {
+		parser := &fakeLabelParser{}
+
+		_, err := NewParseAddressUsecase(parser).Execute(context.Background(), &dto.ParseRequest{Address: "  "})
+
+		var validationErr *domainerrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "address", validationErr.Field)
+		assert.Zero(t, parser.calls)
+	})
+
+	t.Run("parser error", func(t *testing.T) {
+		parseErr := errors.New("parser unavailable")
+
+		_, err := NewParseAddressUsecase(&fakeLabelParser{err: parseErr}).Execute(context.Background(), &dto.ParseRequest{Address: "83702"})
+
+		assert.ErrorIs(t, err, parseErr)
+	})
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+//go:generate mockgen -destination=process_validation_job_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ProcessValidationJobUsecaseInterface
+type ProcessValidationJobUsecaseInterface interface {
+	Execute(ctx context.Context, job *dto.ValidationJob) error
+}
+
+// ProcessValidationJobUsecase runs a published job through the validation pipeline
+// and stores the outcome for the API to pick up.
+type ProcessValidationJobUsecase struct {
+	validator ValidateAddressUsecaseInterface
+	results   ValidationResultStore
+}
+
+// NewProcessValidationJobUsecase creates a new ProcessValidationJobUsecase.
+func NewProcessValidationJobUsecase(
+	validator ValidateAddressUsecaseInterface,
+	results ValidationResultStore,
+) *ProcessValidationJobUsecase {
+	return &ProcessValidationJobUsecase{validator: validator, results: results}
+}
+
+// Execute validates the job's request and stores the outcome. Only a verdict on the
+// request itself, a validation or parsing failure, is stored as a failed result. Any
+// other error, such as a cancelled context, and a failure to store the result are
+// returned without storing anything, so the broker redelivers the job.
+func (uc *ProcessValidationJobUsecase) Execute(ctx context.Context, job *dto.ValidationJob) error {
+	result := &dto.ValidationJobResult{}
+
+	resp, err := uc.validator.Execute(ctx, &job.Request)
+	switch {
+	case err == nil:
+		result.Response = resp
+	case isAddressFailure(err):
+		result.Error = dto.NewValidationJobError(err)
+	default:
+		return err
+	}
+
+	return uc.results.SaveResult(ctx, job.ID, result)
+}
+
+// isAddressFailure reports whether err is a final verdict on the address or request,
+// which every retry would reach again, rather than a failure to reach a verdict.
+func isAddressFailure(err error) bool {
+	for _, problem := range domainerrors.Problems(err) {
+		var (
+			validationErr *domainerrors.ValidationError
+			parsingErr    *domainerrors.ParsingError
+		)
+		if !errors.As(problem, &validationErr) && !errors.As(problem, &parsingErr) {
+			return false
+		}
+	}
+	return true
+}
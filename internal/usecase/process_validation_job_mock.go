@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: ProcessValidationJobUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=process_validation_job_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ProcessValidationJobUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProcessValidationJobUsecaseInterface is a mock of ProcessValidationJobUsecaseInterface interface.
+type MockProcessValidationJobUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProcessValidationJobUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockProcessValidationJobUsecaseInterfaceMockRecorder is the mock recorder for MockProcessValidationJobUsecaseInterface.
+type MockProcessValidationJobUsecaseInterfaceMockRecorder struct {
+	mock *MockProcessValidationJobUsecaseInterface
+}
+
+// NewMockProcessValidationJobUsecaseInterface creates a new mock instance.
+func NewMockProcessValidationJobUsecaseInterface(ctrl *gomock.Controller) *MockProcessValidationJobUsecaseInterface {
+	mock := &MockProcessValidationJobUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockProcessValidationJobUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProcessValidationJobUsecaseInterface) EXPECT() *MockProcessValidationJobUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockProcessValidationJobUsecaseInterface) Execute(ctx context.Context, job *dto.ValidationJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockProcessValidationJobUsecaseInterfaceMockRecorder) Execute(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockProcessValidationJobUsecaseInterface)(nil).Execute), ctx, job)
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
 	"github.com/williandandrade/address-validation-service/internal/domain/entity"
 )
 
@@ -10,3 +12,88 @@ import (
 type ValidateAddressRepository interface {
 	ParseAddress(ctx context.Context, rawAddress string) (*entity.Address, []*entity.Address, error)
 }
+
+// AddressLabelParser defines the contract for inspecting how the parser labels an address.
+type AddressLabelParser interface {
+	ParseLabels(ctx context.Context, rawAddress string) (*entity.ParseTrace, error)
+}
+
+// LocalityRepository defines the contract for resolving ZIP codes from reference data.
+type LocalityRepository interface {
+	LookupZIP(ctx context.Context, zip string) (*entity.Locality, bool)
+}
+
+// AutocompleteRepository defines the contract for prefix lookups over reference data.
+type AutocompleteRepository interface {
+	MatchZIPs(ctx context.Context, prefix string, limit int) []entity.Locality
+	MatchCities(ctx context.Context, prefix, state string, limit int) []entity.Locality
+	// MatchStreets narrows to near's ZIP, city prefix and state, where set.
+	MatchStreets(ctx context.Context, prefix string, near entity.Locality, limit int) []*entity.Street
+	// Source names the reference data and its coverage, such as entity.CoverageSample.
+	Source(ctx context.Context) (name, coverage string)
+}
+
+// AddressSpanFinder defines the contract for locating address spans in unstructured text.
+type AddressSpanFinder interface {
+	FindAddressSpans(text string) []entity.TextSpan
+}
+
+// ValidationJobQueue defines the contract for handing validation jobs to the worker.
+type ValidationJobQueue interface {
+	Publish(ctx context.Context, job *dto.ValidationJob) error
+}
+
+// ValidationResultStore defines the contract for the results the worker writes and the API reads.
+// Implementations key results by the parser and dataset versions as well as id, so a
+// result is never served by a release other than the one that produced it.
+type ValidationResultStore interface {
+	SaveResult(ctx context.Context, id string, result *dto.ValidationJobResult) error
+	LoadResult(ctx context.Context, id string) (*dto.ValidationJobResult, bool, error)
+}
+
+// JobItemResult pairs a job item with its stored outcome; Result is nil while the item is pending.
+type JobItemResult struct {
+	Item   entity.JobItem
+	Result *dto.ValidationJobResult
+}
+
+// JobRepository defines the contract for persisting batch validation jobs.
+type JobRepository interface {
+	CreateJob(ctx context.Context, job *entity.Job, options *dto.ValidateRequest, addresses []string) error
+	GetJob(ctx context.Context, id string) (*entity.Job, bool, error)
+	ListJobResults(ctx context.Context, id string, offset, limit int) ([]*JobItemResult, error)
+
+	// ClaimJob moves the oldest queued job whose next attempt is due to running and
+	// returns it with its options and attempts.
+	ClaimJob(ctx context.Context) (*entity.Job, *dto.ValidateRequest, bool, error)
+	PendingJobItems(ctx context.Context, id string) ([]entity.JobItem, error)
+	// SaveJobItemResult and FinishJob store notify, the webhook reporting the change, in
+	// the same transaction as the change itself, so neither is lost without the other.
+	// notify is nil when the job's callback does not want the event.
+	SaveJobItemResult(ctx context.Context, id string, index int, result *dto.ValidationJobResult, notify *entity.WebhookDelivery) error
+	FinishJob(ctx context.Context, id string, status entity.JobStatus, reason string, notify *entity.WebhookDelivery) error
+	// RequeueJob returns a running job to the queue, keeping its stored results, with
+	// attempts recorded and no claim before nextAttemptAt.
+	RequeueJob(ctx context.Context, id string, attempts int, nextAttemptAt time.Time) error
+	// RequeueRunningJobs returns jobs interrupted by a restart to the queue.
+	RequeueRunningJobs(ctx context.Context) (int, error)
+}
+
+// WebhookRepository defines the contract for persisting webhook deliveries.
+type WebhookRepository interface {
+	GetDelivery(ctx context.Context, id string) (*entity.WebhookDelivery, bool, error)
+	ListDeliveries(ctx context.Context, jobID string) ([]*entity.WebhookDelivery, error)
+	// ClaimDueDeliveries claims up to limit pending deliveries whose next attempt is at or
+	// before now and that no one else holds a claim on. The claim holds until until.
+	ClaimDueDeliveries(ctx context.Context, now, until time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	// UpdateDelivery stores an attempt's outcome unless the delivery changed since it was
+	// claimed, and reports whether it did.
+	UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (bool, error)
+	// ResetDelivery makes a delivery pending and due at now with a fresh set of attempts.
+	ResetDelivery(ctx context.Context, id string, now time.Time) error
+}
+
+// WebhookSender defines the contract for POSTing a webhook; non-2xx responses are errors.
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) error
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+)
+
+// Metric names reported by the in-process result cache. They must be registered
+// with the GoFr metrics manager before the usecase serves requests.
+const (
+	MetricResultCacheHits      = "address_result_cache_hits_total"
+	MetricResultCacheMisses    = "address_result_cache_misses_total"
+	MetricResultCacheCoalesced = "address_result_cache_coalesced_total"
+	MetricResultCacheEvictions = "address_result_cache_evictions_total"
+	MetricResultCacheHitRatio  = "address_result_cache_hit_ratio"
+)
+
+// Metrics is the subset of GoFr's metrics manager the usecase reports to.
+type Metrics interface {
+	IncrementCounter(ctx context.Context, name string, labels ...string)
+	SetGauge(name string, value float64, labels ...string)
+}
+
+// lookup is how the cache answered one request.
+type lookup int
+
+const (
+	// lookupHit was served from the cache.
+	lookupHit lookup = iota
+	// lookupMiss ran the validation.
+	lookupMiss
+	// lookupCoalesced waited for a concurrent miss for the same key to run the validation.
+	lookupCoalesced
+)
+
+type lruEntry struct {
+	key       string
+	value     *dto.ValidateResponse
+	expiresAt time.Time
+}
+
+// resultCache is a bounded LRU of validation responses. Concurrent misses for the
+// same key are coalesced so only one of them runs the validation.
+type resultCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List
+	entries map[string]*list.Element
+	now     func() time.Time
+
+	group     singleflight.Group
+	metrics   Metrics
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	coalesced atomic.Uint64
+}
+
+// newResultCache returns nil when size is not positive, which disables caching.
+func newResultCache(size int, ttl time.Duration, metrics Metrics) *resultCache {
+	if size <= 0 {
+		return nil
+	}
+	return &resultCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+		now:     time.Now,
+		metrics: metrics,
+	}
+}
+
+// do returns the cached response for key, or runs fn once for all concurrent
+// callers asking for the same key and caches a successful result. shared is false
+// only for the caller whose fn produced the response.
+func (c *resultCache) do(
+	ctx context.Context,
+	key string,
+	fn func(context.Context) (*dto.ValidateResponse, error),
+) (resp *dto.ValidateResponse, shared bool, err error) {
+	if resp, ok := c.get(key); ok {
+		c.record(ctx, lookupHit)
+		return resp, true, nil
+	}
+
+	// ran is only read after ch delivers, once fn, if it was this caller's, has returned.
+	ran := false
+	// The shared call must not be cancelled just because the caller that started it went away.
+	ch := c.group.DoChan(key, func() (any, error) {
+		ran = true
+		resp, err := fn(context.WithoutCancel(ctx))
+		if err == nil {
+			c.add(key, resp)
+		}
+		return resp, err
+	})
+
+	outcome := func() lookup {
+		if ran {
+			return lookupMiss
+		}
+		return lookupCoalesced
+	}
+
+	select {
+	case res := <-ch:
+		c.record(ctx, outcome())
+		if res.Err != nil {
+			return nil, false, res.Err
+		}
+		return res.Val.(*dto.ValidateResponse), !ran, nil
+	case <-ctx.Done():
+		// Whether this caller started the shared call is only known once it finishes.
+		go func() {
+			<-ch
+			c.record(context.WithoutCancel(ctx), outcome())
+		}()
+		return nil, false, ctx.Err()
+	}
+}
+
+func (c *resultCache) get(key string) (*dto.ValidateResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *resultCache) add(key string, value *dto.ValidateResponse) {
+	entry := &lruEntry{key: key, value: value}
+	if c.ttl > 0 {
+		entry.expiresAt = c.now().Add(c.ttl)
+	}
+
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		c.mu.Unlock()
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+
+	evicted := 0
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+		evicted++
+	}
+	c.mu.Unlock()
+
+	if c.metrics != nil {
+		for range evicted {
+			c.metrics.IncrementCounter(context.Background(), MetricResultCacheEvictions)
+		}
+	}
+}
+
+// len reports the number of entries, including expired ones not yet removed.
+func (c *resultCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// record counts one lookup. The hit ratio is over every lookup, so coalesced requests,
+// which neither came from the cache nor ran the validation, lower it like misses do.
+func (c *resultCache) record(ctx context.Context, outcome lookup) {
+	var metric string
+	switch outcome {
+	case lookupHit:
+		c.hits.Add(1)
+		metric = MetricResultCacheHits
+	case lookupMiss:
+		c.misses.Add(1)
+		metric = MetricResultCacheMisses
+	case lookupCoalesced:
+		c.coalesced.Add(1)
+		metric = MetricResultCacheCoalesced
+	}
+
+	if c.metrics == nil {
+		return
+	}
+	hits := c.hits.Load()
+	lookups := hits + c.misses.Load() + c.coalesced.Load()
+	c.metrics.IncrementCounter(ctx, metric)
+	c.metrics.SetGauge(MetricResultCacheHitRatio, float64(hits)/float64(lookups))
+}
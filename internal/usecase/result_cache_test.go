@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+type fakeMetrics struct {
+	mu       sync.Mutex
+	counters map[string]int
+	gauges   map[string]float64
+}
+
+func newFakeMetrics() *fakeMetrics {
+	return &fakeMetrics{counters: make(map[string]int), gauges: make(map[string]float64)}
+}
+
+func (m *fakeMetrics) IncrementCounter(_ context.Context, name string, _ ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name]++
+}
+
+func (m *fakeMetrics) SetGauge(name string, value float64, _ ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gauges[name] = value
+}
+
+func respond(message string) func(context.Context) (*dto.ValidateResponse, error) {
+	return func(context.Context) (*dto.ValidateResponse, error) {
+		return &dto.ValidateResponse{Success: true, Message: message}, nil
+	}
+}
+
+func TestResultCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	metrics := newFakeMetrics()
+	c := newResultCache(2, 0, metrics)
+	ctx := context.Background()
+
+	_, _, _ = c.do(ctx, "a", respond("a"))
+	_, _, _ = c.do(ctx, "b", respond("b"))
+	_, _, _ = c.do(ctx, "a", respond("a")) // a is now the most recently used
+	_, _, _ = c.do(ctx, "c", respond("c"))
+
+	assert.Equal(t, 2, c.len())
+	_, ok := c.get("b")
+	assert.False(t, ok, "b should have been evicted")
+	_, ok = c.get("a")
+	assert.True(t, ok)
+
+	assert.Equal(t, 1, metrics.counters[MetricResultCacheEvictions])
+	assert.Equal(t, 1, metrics.counters[MetricResultCacheHits])
+	assert.Equal(t, 3, metrics.counters[MetricResultCacheMisses])
+	assert.InDelta(t, 0.25, metrics.gauges[MetricResultCacheHitRatio], 0.001)
+}
+
+func TestResultCache_ExpiresEntries(t *testing.T) {
+	c := newResultCache(10, time.Minute, nil)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	resp, shared, err := c.do(context.Background(), "k", respond("first"))
+	require.NoError(t, err)
+	assert.False(t, shared)
+	assert.Equal(t, "first", resp.Message)
+
+	resp, shared, _ = c.do(context.Background(), "k", respond("second"))
+	assert.True(t, shared)
+	assert.Equal(t, "first", resp.Message)
+
+	now = now.Add(time.Minute)
+	resp, shared, _ = c.do(context.Background(), "k", respond("third"))
+	assert.False(t, shared)
+	assert.Equal(t, "third", resp.Message)
+}
+
+func TestResultCache_DoesNotCacheErrors(t *testing.T) {
+	c := newResultCache(10, 0, nil)
+	calls := 0
+	fail := func(context.Context) (*dto.ValidateResponse, error) {
+		calls++
+		return nil, errors.New("boom")
+	}
+
+	_, _, err := c.do(context.Background(), "k", fail)
+	require.Error(t, err)
+	_, _, err = c.do(context.Background(), "k", fail)
+	require.Error(t, err)
+
+	assert.Equal(t, 2, calls)
+	assert.Zero(t, c.len())
+}
+
+func TestResultCache_CoalescesConcurrentMisses(t *testing.T) {
+	metrics := newFakeMetrics()
+	c := newResultCache(10, 0, metrics)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	slow := func(context.Context) (*dto.ValidateResponse, error) {
+		calls.Add(1)
+		<-release
+		return &dto.ValidateResponse{Success: true}, nil
+	}
+
+	const callers = 8
+	var wg sync.WaitGroup
+	var shared atomic.Int32
+	started := make(chan struct{}, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started <- struct{}{}
+			resp, wasShared, err := c.do(context.Background(), "k", slow)
+			assert.NoError(t, err)
+			assert.True(t, resp.Success)
+			if wasShared {
+				shared.Add(1)
+			}
+		}()
+	}
+	for range callers {
+		<-started
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, int32(callers-1), shared.Load())
+	assert.Equal(t, 1, metrics.counters[MetricResultCacheMisses])
+	assert.Equal(t, callers-1, metrics.counters[MetricResultCacheCoalesced])
+	assert.Zero(t, metrics.counters[MetricResultCacheHits])
+}
+
+func TestValidateAddressUsecase_ResultCache(t *testing.T) {
+	repo := &countingRepo{}
+	uc := NewValidateAddressUsecase(repo, nil, ValidateAddressConfig{CacheSize: 10})
+	ctx := context.Background()
+
+	first, err := uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701"})
+	require.NoError(t, err)
+	assert.Nil(t, first.Metadata)
+
+	second, err := uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701"})
+	require.NoError(t, err)
+	require.NotNil(t, second.Metadata)
+	assert.Equal(t, entity.CacheHit, second.Metadata.Cache)
+	assert.Equal(t, first.Address, second.Address)
+	assert.Nil(t, first.Metadata, "the cached response must not be modified")
+
+	first.Address.City = "Shelbyville"
+	second.Address.Formatted = nil
+	third, err := uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701"})
+	require.NoError(t, err)
+	assert.Equal(t, "Springfield", third.Address.City, "callers must not share memory with the cache")
+
+	_, err = uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701", IncludeComponents: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, repo.calls, "requests with different options are cached separately")
+
+	for range 2 {
+		explained, err := uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701", Explain: true})
+		require.NoError(t, err)
+		assert.Nil(t, explained.Metadata)
+		assert.NotEmpty(t, explained.Trace)
+	}
+	assert.Equal(t, 4, repo.calls, "explained requests are never cached")
+}
+
+type countingRepo struct {
+	calls int
+}
+
+func (r *countingRepo) ParseAddress(_ context.Context, _ string) (*entity.Address, []*entity.Address, error) {
+	r.calls++
+	return &entity.Address{
+		StreetAddress: "123 Main St",
+		City:          "Springfield",
+		State:         "IL",
+		PostalCode:    "62701",
+	}, nil, nil
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+)
+
+const (
+	defaultJobMaxAttempts = 5
+	defaultJobBaseBackoff = 10 * time.Second
+	defaultJobMaxBackoff  = 10 * time.Minute
+)
+
+// RunJobsConfig holds the retry policy for jobs whose run stops without finishing.
+type RunJobsConfig struct {
+	// MaxAttempts is how many stopped runs fail the job. Zero means 5.
+	MaxAttempts int
+	// BaseBackoff is the wait before the job is claimed again after its first stopped
+	// run; it doubles per attempt up to MaxBackoff. Zero values use 10s and 10m.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// RunJobsUsecase processes queued batch jobs through the validation pipeline.
+type RunJobsUsecase struct {
+	repo      JobRepository
+	validator ValidateAddressUsecaseInterface
+	notifier  *WebhookNotifier
+	config    RunJobsConfig
+	now       func() time.Time
+}
+
+// NewRunJobsUsecase creates a new RunJobsUsecase. notifier may be nil when webhooks are disabled.
+func NewRunJobsUsecase(repo JobRepository, validator ValidateAddressUsecaseInterface, notifier *WebhookNotifier, config RunJobsConfig) *RunJobsUsecase {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultJobMaxAttempts
+	}
+	if config.BaseBackoff <= 0 {
+		config.BaseBackoff = defaultJobBaseBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaultJobMaxBackoff
+	}
+	return &RunJobsUsecase{repo: repo, validator: validator, notifier: notifier, config: config, now: time.Now}
+}
+
+// Execute claims the oldest queued job and validates its pending addresses, storing each
+// result as it goes so a restarted job resumes where it stopped. It reports whether a job
+// was claimed. An address the validator could not reach a verdict on, say because ctx
+// was cancelled, is left pending and the job is returned to the queue to be retried
+// with backoff; after MaxAttempts such runs, or on failing to store results, the job
+// fails.
+func (uc *RunJobsUsecase) Execute(ctx context.Context) (bool, error) {
+	job, options, ok, err := uc.repo.ClaimJob(ctx)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	items, err := uc.repo.PendingJobItems(ctx, job.ID)
+	if err != nil {
+		return true, uc.fail(ctx, job, err)
+	}
+
+	for _, item := range items {
+		request := *options
+		request.Address = item.Address
+
+		result := &dto.ValidationJobResult{}
+		resp, err := uc.validator.Execute(ctx, &request)
+		switch {
+		case err == nil:
+			result.Response = resp
+		case isAddressFailure(err):
+			result.Error = dto.NewValidationJobError(err)
+		default:
+			return true, uc.requeue(ctx, job, err)
+		}
+
+		item.Status = entity.JobItemSucceeded
+		if result.Error != nil {
+			item.Status = entity.JobItemFailed
+		}
+		notify := uc.notification(func(n *WebhookNotifier) (*entity.WebhookDelivery, error) {
+			return n.ItemCompleted(job, &JobItemResult{Item: item, Result: result})
+		})
+		if err := uc.repo.SaveJobItemResult(ctx, job.ID, item.Index, result, notify); err != nil {
+			return true, uc.fail(ctx, job, err)
+		}
+	}
+
+	return true, uc.finish(ctx, job, entity.JobDone, "")
+}
+
+// requeue returns job to the queue after cause stopped it, so its pending items are
+// retried once the backoff for its attempts has passed. A cancelled ctx means the
+// worker is stopping, not that the job is at fault, so it requeues the job at once
+// without counting an attempt; the job is requeued even then. Any other cause counts,
+// and fails the job once it has stopped MaxAttempts runs.
+func (uc *RunJobsUsecase) requeue(ctx context.Context, job *entity.Job, cause error) error {
+	attempts, next := job.Attempts, uc.now().UTC()
+	if ctx.Err() == nil {
+		attempts++
+		if attempts >= uc.config.MaxAttempts {
+			return uc.fail(ctx, job, fmt.Errorf("gave up after %d attempts: %w", attempts, cause))
+		}
+		next = next.Add(uc.backoff(attempts))
+	}
+
+	if err := uc.repo.RequeueJob(context.WithoutCancel(ctx), job.ID, attempts, next); err != nil {
+		return err
+	}
+	return cause
+}
+
+// backoff returns the wait after the given number of stopped runs.
+func (uc *RunJobsUsecase) backoff(attempts int) time.Duration {
+	wait := uc.config.BaseBackoff
+	for i := 1; i < attempts && wait < uc.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, uc.config.MaxBackoff)
+}
+
+func (uc *RunJobsUsecase) fail(ctx context.Context, job *entity.Job, cause error) error {
+	if err := uc.finish(ctx, job, entity.JobFailed, cause.Error()); err != nil {
+		return err
+	}
+	return cause
+}
+
+// finish records the job's final status together with its job.completed webhook, which
+// carries the job's final state whether it finished or failed.
+func (uc *RunJobsUsecase) finish(ctx context.Context, job *entity.Job, status entity.JobStatus, reason string) error {
+	var notify *entity.WebhookDelivery
+	if uc.notifier != nil && job.Callback.Wants(entity.EventJobCompleted) {
+		final, ok, err := uc.repo.GetJob(ctx, job.ID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			final.Status, final.Error = status, reason
+			notify = uc.notification(func(n *WebhookNotifier) (*entity.WebhookDelivery, error) {
+				return n.JobCompleted(final)
+			})
+		}
+	}
+	return uc.repo.FinishJob(ctx, job.ID, status, reason, notify)
+}
+
+// notification builds a webhook with the notifier, if there is one. A webhook that cannot
+// be built is dropped rather than failing the job, whose results are kept either way.
+func (uc *RunJobsUsecase) notification(build func(n *WebhookNotifier) (*entity.WebhookDelivery, error)) *entity.WebhookDelivery {
+	if uc.notifier == nil {
+		return nil
+	}
+	delivery, err := build(uc.notifier)
+	if err != nil {
+		return nil
+	}
+	return delivery
+}
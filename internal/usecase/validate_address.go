@@ -2,7 +2,13 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"math"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/williandandrade/address-validation-service/internal/api/dto"
 	"github.com/williandandrade/address-validation-service/internal/domain/entity"
@@ -14,25 +20,94 @@ type ValidateAddressUsecaseInterface interface {
 	Execute(ctx context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error)
 }
 
+// ValidateAddressConfig holds service-wide defaults for ValidateAddressUsecase.
+type ValidateAddressConfig struct {
+	// DefaultStrictness applies when a request does not set one; empty means standard.
+	DefaultStrictness entity.Strictness
+	// CacheSize bounds the in-process response cache; zero disables it.
+	CacheSize int
+	// CacheTTL is how long a cached response is served; zero keeps it until evicted.
+	CacheTTL time.Duration
+	// Metrics receives cache hit, miss and eviction counts; nil disables reporting.
+	Metrics Metrics
+}
+
 // ValidateAddressUsecase handles address validation business logic.
 type ValidateAddressUsecase struct {
-	repo ValidateAddressRepository
+	repo       ValidateAddressRepository
+	localities LocalityRepository
+	config     ValidateAddressConfig
+	cache      *resultCache
 }
 
 // NewValidateAddressUsecase creates a new ValidateAddressUsecase.
-func NewValidateAddressUsecase(repo ValidateAddressRepository) *ValidateAddressUsecase {
-	return &ValidateAddressUsecase{repo: repo}
+func NewValidateAddressUsecase(
+	repo ValidateAddressRepository,
+	localities LocalityRepository,
+	config ValidateAddressConfig,
+) *ValidateAddressUsecase {
+	if config.DefaultStrictness == "" {
+		config.DefaultStrictness = entity.StrictnessStandard
+	}
+	return &ValidateAddressUsecase{
+		repo:       repo,
+		localities: localities,
+		config:     config,
+		cache:      newResultCache(config.CacheSize, config.CacheTTL, config.Metrics),
+	}
+}
+
+// modeSuggestions tells the caller what each mode needs when validation fails.
+var modeSuggestions = map[entity.ValidationMode]string{
+	entity.ModeFull:     "Ensure address contains at least street address, city, and state",
+	entity.ModeLocality: "Ensure address contains a city and a state",
+	entity.ModePostal:   "Ensure address contains a 5-digit or ZIP+4 code",
 }
 
-// Execute validates and normalizes a raw address string.
+// Execute validates and normalizes a raw address string. Identical requests are
+// served from the in-process cache when one is configured, each as a copy the caller
+// owns; explained requests always run, so their trace shows the decisions actually taken.
 func (uc *ValidateAddressUsecase) Execute(ctx context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
-	rawAddress := strings.TrimSpace(input.Address)
-	if rawAddress == "" {
-		return nil, &domainerrors.ValidationError{
-			Field:      "address",
-			Reason:     "address field is required and cannot be empty",
-			Suggestion: "Provide a valid US address",
-		}
+	if uc.cache == nil || input.Explain {
+		return uc.execute(ctx, input)
+	}
+
+	// Every request field can change the response, so the whole request is the key.
+	key, err := json.Marshal(input)
+	if err != nil {
+		return uc.execute(ctx, input)
+	}
+
+	resp, shared, err := uc.cache.do(ctx, string(key), func(ctx context.Context) (*dto.ValidateResponse, error) {
+		return uc.execute(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cache keeps resp, so every caller, including the one that produced it,
+	// gets its own copy to change.
+	out := resp.Clone()
+	if shared {
+		out.Metadata = &dto.MetadataDTO{Cache: entity.CacheHit}
+	}
+	return out, nil
+}
+
+func (uc *ValidateAddressUsecase) execute(ctx context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+	opts, err := resolveRequest(input, uc.config.DefaultStrictness)
+	if err != nil {
+		return nil, err
+	}
+	rawAddress, mode, strictness := opts.rawAddress, opts.mode, opts.strictness
+
+	meta := &entity.ParseMetadata{}
+	ctx = entity.ContextWithParseMetadata(ctx, meta)
+
+	var trace *entity.DecisionTrace
+	if input.Explain {
+		trace = &entity.DecisionTrace{}
+		ctx = entity.ContextWithDecisionTrace(ctx, trace)
 	}
 
 	addr, candidates, err := uc.repo.ParseAddress(ctx, rawAddress)
@@ -40,74 +115,456 @@ func (uc *ValidateAddressUsecase) Execute(ctx context.Context, input *dto.Valida
 		return nil, err
 	}
 
-	if err := addr.Validate(); err != nil {
-		return nil, &domainerrors.ParsingError{
-			Field:      "address",
-			Reason:     err.Error(),
-			Suggestion: "Ensure address contains at least street address, city, and state",
+	if mode == entity.ModePostal {
+		uc.resolveLocality(ctx, addr, trace)
+	}
+
+	if strictness == entity.StrictnessLenient {
+		dropMalformedComponents(addr, trace)
+	}
+
+	if err := addr.ValidatePolicy(entity.ValidationPolicy{Mode: mode, Strictness: strictness}); err != nil {
+		for _, problem := range domainerrors.Problems(err) {
+			var policyErr *domainerrors.ParsingError
+			if errors.As(problem, &policyErr) && policyErr.Suggestion == "" {
+				policyErr.Suggestion = modeSuggestions[mode]
+			}
 		}
+		return nil, err
 	}
+	trace.Addf(entity.StagePolicy, "required_components", "", "",
+		"The address has the components %s mode requires at %s strictness", mode, strictness)
 
-	uc.assignConfidence(addr)
+	uc.assignConfidence(addr, len(candidates) > 0, trace)
 	addr.FormatAddress()
+	entity.TraceStreetLine(addr.StreetAddress, trace)
 
 	for _, cand := range candidates {
 		cand.FormatAddress()
 	}
 
 	resp := &dto.ValidateResponse{
-		Success: true,
-		Address: mapAddressToDTO(addr),
-		Message: "Address validated successfully",
+		Success:    true,
+		Status:     dto.StatusValid,
+		Strictness: string(strictness),
+		Address:    mapAddressToDTO(addr, opts.format),
 	}
+	resp.SetMessage(dto.MessageValidated)
 
 	if addr.Confidence != nil {
 		resp.Confidence = &dto.ConfidenceDTO{
 			StateConfidence:  addr.Confidence.StateConfidence,
 			CityConfidence:   addr.Confidence.CityConfidence,
 			PostalConfidence: addr.Confidence.PostalConfidence,
+			StreetScore:      addr.Confidence.StreetScore,
+			CityScore:        addr.Confidence.CityScore,
+			StateScore:       addr.Confidence.StateScore,
+			PostalScore:      addr.Confidence.PostalScore,
+			Overall:          addr.Confidence.Overall,
 		}
 	}
 
-	if len(addr.CorrectionsApplied) > 0 {
-		resp.CorrectionsApplied = addr.CorrectionsApplied
+	if len(addr.Corrections) > 0 {
+		resp.Corrections = mapCorrectionsToDTO(addr.Corrections)
+		resp.CorrectionsApplied = entity.CorrectionMessages(addr.Corrections)
+		resp.Status = dto.StatusCorrected
+	}
+
+	resp.Warnings = mapWarningsToDTO(addr.Warnings())
+	if len(candidates) > 0 {
+		resp.Warnings = append(resp.Warnings, newWarningDTO(domainerrors.CodeAddressAmbiguous, "address",
+			"the address has more than one valid interpretation; candidates lists the others"))
+	}
+
+	if meta.Cache != "" {
+		resp.Metadata = &dto.MetadataDTO{Cache: meta.Cache}
+	}
+
+	if input.IncludeComponents {
+		resp.Components = mapComponentsToDTO(addr, leadingRunes(input.Address))
 	}
 
 	if len(candidates) > 0 {
 		for _, cand := range candidates {
-			resp.Candidates = append(resp.Candidates, mapAddressToDTO(cand))
+			resp.Candidates = append(resp.Candidates, mapAddressToDTO(cand, opts.format))
 		}
-		resp.Message = "Multiple valid interpretations found; returning most populous match"
+		resp.SetMessage(dto.MessageAmbiguous)
+	}
+
+	if input.MinConfidence > 0 && addr.Confidence.Overall < input.MinConfidence {
+		resp.Status = dto.StatusUnverifiable
+		resp.SetMessage(dto.MessageLowConfidence)
+		trace.Addf(entity.StageConfidence, "min_confidence", "", "",
+			"Marked the address unverifiable: overall score %.2f is below the requested minimum %.2f",
+			addr.Confidence.Overall, input.MinConfidence)
+	}
+
+	if trace != nil {
+		resp.Trace = mapTraceToDTO(trace)
 	}
 
 	return resp, nil
 }
 
-func (uc *ValidateAddressUsecase) assignConfidence(addr *entity.Address) {
+func mapCorrectionsToDTO(corrections []entity.Correction) []*dto.CorrectionDTO {
+	out := make([]*dto.CorrectionDTO, 0, len(corrections))
+	for _, c := range corrections {
+		out = append(out, &dto.CorrectionDTO{
+			Component: c.Component,
+			Original:  c.Original,
+			Corrected: c.Corrected,
+			Type:      string(c.Type),
+			Reason:    c.Reason,
+		})
+	}
+	return out
+}
+
+func mapWarningsToDTO(warnings []entity.Warning) []dto.WarningDTO {
+	var out []dto.WarningDTO
+	for _, w := range warnings {
+		out = append(out, newWarningDTO(w.Code, w.Component, w.Message))
+	}
+	return out
+}
+
+func newWarningDTO(code domainerrors.Code, field, message string) dto.WarningDTO {
+	return dto.WarningDTO{Code: string(code), DocsURL: code.DocsURL(), Field: field, Message: message}
+}
+
+// mapTraceToDTO lists the recorded decisions in the order they were taken.
+func mapTraceToDTO(trace *entity.DecisionTrace) []*dto.DecisionDTO {
+	decisions := trace.Decisions()
+	out := make([]*dto.DecisionDTO, 0, len(decisions))
+	for _, d := range decisions {
+		out = append(out, &dto.DecisionDTO{
+			Stage:     d.Stage,
+			Rule:      d.Rule,
+			Component: d.Component,
+			Value:     d.Value,
+			Detail:    d.Detail,
+		})
+	}
+	return out
+}
+
+// requestOptions are the request fields resolved against the service defaults.
+type requestOptions struct {
+	rawAddress string
+	mode       entity.ValidationMode
+	strictness entity.Strictness
+	// format is the requested output format; an empty Format adds none.
+	format entity.FormatOptions
+}
+
+// resolveRequest performs the fail-fast checks that need no parsing.
+func resolveRequest(input *dto.ValidateRequest, defaultStrictness entity.Strictness) (*requestOptions, error) {
+	rawAddress := strings.TrimSpace(input.Address)
+	if rawAddress == "" {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeAddressEmpty,
+			Field:      "address",
+			Reason:     "address field is required and cannot be empty",
+			Suggestion: "Provide a valid US address",
+		}
+	}
+
+	opts, err := resolveOptions(input, defaultStrictness)
+	if err != nil {
+		return nil, err
+	}
+	opts.rawAddress = rawAddress
+
+	return opts, nil
+}
+
+// resolveOptions checks and resolves the request options, leaving the address to the caller.
+func resolveOptions(input *dto.ValidateRequest, defaultStrictness entity.Strictness) (*requestOptions, error) {
+	if input.MinConfidence < 0 || input.MinConfidence > 1 {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldOutOfRange,
+			Field:      "min_confidence",
+			Reason:     "min_confidence must be between 0 and 1",
+			Value:      input.MinConfidence,
+			Suggestion: "Use a threshold such as 0.7, or omit the field",
+		}
+	}
+
+	mode := entity.ModeFull
+	if input.Mode != "" {
+		mode = entity.ValidationMode(strings.ToLower(input.Mode))
+	}
+	if !mode.IsValid() {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      "mode",
+			Reason:     "mode must be one of full, locality, postal",
+			Value:      input.Mode,
+			Suggestion: "Omit mode for full addresses, or use locality or postal",
+		}
+	}
+
+	strictness := defaultStrictness
+	if input.Strictness != "" {
+		strictness = entity.Strictness(strings.ToLower(input.Strictness))
+	}
+	if !strictness.IsValid() {
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      "strictness",
+			Reason:     "strictness must be one of strict, standard, lenient",
+			Value:      input.Strictness,
+			Suggestion: "Omit strictness to use the service default",
+		}
+	}
+
+	format, err := resolveFormat(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestOptions{mode: mode, strictness: strictness, format: format}, nil
+}
+
+// resolveFormat checks the output format options.
+func resolveFormat(input *dto.ValidateRequest) (entity.FormatOptions, error) {
+	format := entity.AddressFormat(strings.ToLower(input.Format))
+	if format != "" && !format.IsValid() {
+		return entity.FormatOptions{}, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      "format",
+			Reason:     "format must be one of single_line, multi_line, usps_label",
+			Value:      input.Format,
+			Suggestion: "Omit format to receive only formatted_address",
+		}
+	}
+
+	if input.MaxLineLength != 0 {
+		if format == "" {
+			return entity.FormatOptions{}, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeFieldRequired,
+				Field:      "max_line_length",
+				Reason:     "max_line_length requires a format",
+				Value:      input.MaxLineLength,
+				Suggestion: "Set format to usps_label, multi_line or single_line",
+			}
+		}
+		if input.MaxLineLength < entity.MinLineLength || input.MaxLineLength > entity.MaxLineLength {
+			return entity.FormatOptions{}, &domainerrors.ValidationError{
+				Code:       domainerrors.CodeFieldOutOfRange,
+				Field:      "max_line_length",
+				Reason:     "max_line_length must be between 20 and 200",
+				Value:      input.MaxLineLength,
+				Suggestion: "Use your carrier's limit, such as 35 or 40",
+			}
+		}
+	}
+
+	return entity.FormatOptions{Format: format, MaxLineLength: input.MaxLineLength}, nil
+}
+
+// resolveLocality fills a missing city and state from the ZIP code's reference locality.
+func (uc *ValidateAddressUsecase) resolveLocality(ctx context.Context, addr *entity.Address, trace *entity.DecisionTrace) {
+	if addr.PostalCode == "" || uc.localities == nil || (addr.City != "" && addr.State != "") {
+		return
+	}
+
+	loc, ok := uc.localities.LookupZIP(ctx, addr.PostalCode)
+	if !ok {
+		trace.Addf(entity.StageReference, "zip_locality", entity.ComponentPostalCode, addr.PostalCode,
+			"ZIP code %s has no reference locality, so the missing city and state stay empty", addr.PostalCode)
+		return
+	}
+
+	if addr.Evidence == nil {
+		addr.Evidence = make(map[string]entity.Evidence)
+	}
+	if addr.City == "" && loc.City != "" {
+		addr.City = loc.City
+		addr.Evidence[entity.ComponentCity] = entity.EvidenceInferred
+		trace.Addf(entity.StageReference, "zip_locality", entity.ComponentCity, loc.City,
+			"Filled the missing city from ZIP code %s's reference locality", addr.PostalCode)
+	}
+	if addr.State == "" {
+		addr.State = loc.State
+		addr.Evidence[entity.ComponentState] = entity.EvidenceInferred
+		trace.Addf(entity.StageReference, "zip_locality", entity.ComponentState, loc.State,
+			"Filled the missing state from ZIP code %s's reference locality", addr.PostalCode)
+	}
+}
+
+// dropMalformedComponents clears state and ZIP values that would fail format checks,
+// so lenient validation returns the rest of the address instead of an error.
+func dropMalformedComponents(addr *entity.Address, trace *entity.DecisionTrace) {
+	if addr.State != "" && !entity.ValidUSStates[strings.ToUpper(addr.State)] {
+		trace.Addf(entity.StagePolicy, "lenient_drop", entity.ComponentState, addr.State,
+			"Dropped the state %s: it is not a USPS state code, and lenient strictness drops it instead of failing", addr.State)
+		addr.Corrections = append(addr.Corrections, entity.Correction{
+			Component: entity.ComponentState,
+			Original:  addr.State,
+			Type:      entity.CorrectionDropped,
+			Reason:    "Not a USPS state code",
+		})
+		addr.State = ""
+		delete(addr.Evidence, entity.ComponentState)
+	}
+	if addr.PostalCode != "" && !entity.IsValidPostalCode(addr.PostalCode) {
+		trace.Addf(entity.StagePolicy, "lenient_drop", entity.ComponentPostalCode, addr.PostalCode,
+			"Dropped the ZIP code %s: it is malformed, and lenient strictness drops it instead of failing", addr.PostalCode)
+		addr.Corrections = append(addr.Corrections, entity.Correction{
+			Component: entity.ComponentPostalCode,
+			Original:  addr.PostalCode,
+			Type:      entity.CorrectionDropped,
+			Reason:    "Not a 5-digit or ZIP+4 code",
+		})
+		addr.PostalCode = ""
+		delete(addr.Evidence, entity.ComponentPostalCode)
+	}
+}
+
+// ambiguityPenalty scales the overall score down when the parser found competing interpretations.
+const ambiguityPenalty = 0.8
+
+func (uc *ValidateAddressUsecase) assignConfidence(addr *entity.Address, ambiguous bool, trace *entity.DecisionTrace) {
 	if addr.Confidence == nil {
 		addr.Confidence = &entity.Confidence{}
 	}
 
 	if addr.State != "" {
-		addr.Confidence.StateConfidence = "direct"
+		addr.Confidence.StateConfidence = sourceOf(addr, entity.ComponentState)
 	}
 	if addr.City != "" {
-		addr.Confidence.CityConfidence = "direct"
+		addr.Confidence.CityConfidence = sourceOf(addr, entity.ComponentCity)
 	}
 	if addr.PostalCode != "" {
 		addr.Confidence.PostalConfidence = "direct"
 	} else {
 		addr.Confidence.PostalConfidence = "inferred"
 	}
+
+	scores := map[string]float64{
+		entity.ComponentStreetAddress: componentScore(addr, entity.ComponentStreetAddress, addr.StreetAddress),
+		entity.ComponentCity:          componentScore(addr, entity.ComponentCity, addr.City),
+		entity.ComponentState:         componentScore(addr, entity.ComponentState, addr.State),
+		entity.ComponentPostalCode:    componentScore(addr, entity.ComponentPostalCode, addr.PostalCode),
+	}
+
+	for _, name := range scoredComponents {
+		traceScore(trace, addr, name, scores[name])
+	}
+
+	overall := entity.OverallScore(scores)
+	if ambiguous {
+		trace.Addf(entity.StageConfidence, "ambiguity_penalty", "", "",
+			"Lowered the overall score from %.2f to %.2f: the parser found competing interpretations", overall, overall*ambiguityPenalty)
+		overall *= ambiguityPenalty
+	}
+
+	addr.Confidence.StreetScore = roundScore(scores[entity.ComponentStreetAddress])
+	addr.Confidence.CityScore = roundScore(scores[entity.ComponentCity])
+	addr.Confidence.StateScore = roundScore(scores[entity.ComponentState])
+	addr.Confidence.PostalScore = roundScore(scores[entity.ComponentPostalCode])
+	addr.Confidence.Overall = roundScore(overall)
+}
+
+// scoredComponents lists the scored components in address order, for the trace.
+var scoredComponents = []string{
+	entity.ComponentStreetAddress, entity.ComponentCity, entity.ComponentState, entity.ComponentPostalCode,
+}
+
+// traceScore records the evidence behind a component's score.
+func traceScore(trace *entity.DecisionTrace, addr *entity.Address, name string, score float64) {
+	if score == 0 {
+		trace.Addf(entity.StageConfidence, "missing_component", name, "",
+			"Scored %s 0: it is missing", name)
+		return
+	}
+	evidence, ok := addr.Evidence[name]
+	if !ok {
+		trace.Addf(entity.StageConfidence, "evidence_score", name, "",
+			"Scored %s %.2f: the parser gave no evidence, so it is treated as segmented", name, score)
+		return
+	}
+	trace.Addf(entity.StageConfidence, "evidence_score", name, string(evidence),
+		"Scored %s %.2f from %s evidence", name, score, evidence)
+}
+
+// sourceOf reports whether a present component came from the input ("direct") or was filled in ("inferred").
+func sourceOf(addr *entity.Address, name string) string {
+	if addr.Evidence[name] == entity.EvidenceInferred {
+		return "inferred"
+	}
+	return "direct"
 }
 
-func mapAddressToDTO(addr *entity.Address) *dto.AddressDTO {
-	return &dto.AddressDTO{
+// componentScore scores a present component from the parser's evidence. Components the
+// parser gave no evidence for are treated as positional guesses.
+func componentScore(addr *entity.Address, name, value string) float64 {
+	if value == "" {
+		return 0
+	}
+	if evidence, ok := addr.Evidence[name]; ok {
+		return evidence.Score()
+	}
+	return entity.EvidenceSegmented.Score()
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
+
+// mapComponentsToDTO pairs each located component with its normalized value. Parsers
+// see the trimmed input, so offsets are shifted back by the trimmed leading whitespace.
+func mapComponentsToDTO(addr *entity.Address, shift int) map[string]*dto.ComponentDTO {
+	if len(addr.Components) == 0 {
+		return nil
+	}
+
+	values := map[string]string{
+		entity.ComponentStreetAddress: addr.StreetAddress,
+		entity.ComponentCity:          addr.City,
+		entity.ComponentState:         addr.State,
+		entity.ComponentPostalCode:    addr.PostalCode,
+	}
+
+	components := make(map[string]*dto.ComponentDTO, len(addr.Components))
+	for name, span := range addr.Components {
+		components[name] = &dto.ComponentDTO{
+			Value: values[name],
+			Raw:   span.Text,
+			Start: span.Start + shift,
+			End:   span.End + shift,
+		}
+	}
+	return components
+}
+
+func leadingRunes(s string) int {
+	return utf8.RuneCountInString(s) - utf8.RuneCountInString(strings.TrimLeftFunc(s, unicode.IsSpace))
+}
+
+func mapAddressToDTO(addr *entity.Address, format entity.FormatOptions) *dto.AddressDTO {
+	out := &dto.AddressDTO{
 		StreetAddress:    addr.StreetAddress,
 		City:             addr.City,
 		State:            addr.State,
 		PostalCode:       addr.PostalCode,
 		AddressType:      addr.AddressType,
 		FormattedAddress: addr.FormattedAddress,
+		CanonicalKey:     addr.CanonicalKey(),
+		CanonicalHash:    addr.CanonicalHash(),
+	}
+
+	if format.Format != "" {
+		formatted := addr.Format(format)
+		out.Formatted = &dto.FormattedDTO{
+			Format:        string(format.Format),
+			MaxLineLength: format.MaxLineLength,
+			Lines:         formatted.Lines,
+			Text:          strings.Join(formatted.Lines, "\n"),
+			Abbreviated:   formatted.Abbreviated,
+			Truncated:     formatted.Truncated,
+		}
 	}
+	return out
 }
@@ -20,6 +20,102 @@ func (m *mockRepo) ParseAddress(ctx context.Context, raw string) (primary *entit
 	return m.parseFn(ctx, raw)
 }
 
+type mockLocalities struct {
+	byZIP map[string]*entity.Locality
+}
+
+func (m *mockLocalities) LookupZIP(_ context.Context, zip string) (*entity.Locality, bool) {
+	loc, ok := m.byZIP[zip]
+	return loc, ok
+}
+
+func TestValidateAddressUsecase_DefaultStrictness(t *testing.T) {
+	repo := &mockRepo{
+		parseFn: func(_ context.Context, _ string) (*entity.Address, []*entity.Address, error) {
+			return &entity.Address{StreetAddress: "123 Main St", City: "New York", State: "NY"}, nil, nil
+		},
+	}
+
+	uc := NewValidateAddressUsecase(repo, &mockLocalities{}, ValidateAddressConfig{DefaultStrictness: entity.StrictnessStrict})
+
+	_, err := uc.Execute(context.Background(), &dto.ValidateRequest{Address: "123 Main St, New York, NY"})
+	var pe *domainerrors.ParsingError
+	require.ErrorAs(t, err, &pe)
+	assert.Contains(t, pe.Reason, "strict")
+
+	resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{
+		Address:    "123 Main St, New York, NY",
+		Strictness: "standard",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "standard", resp.Strictness)
+}
+
+func TestValidateAddressUsecase_CacheMetadata(t *testing.T) {
+	repo := &mockRepo{
+		parseFn: func(ctx context.Context, _ string) (*entity.Address, []*entity.Address, error) {
+			entity.ParseMetadataFromContext(ctx).Cache = entity.CacheHit
+			return &entity.Address{StreetAddress: "123 Main St", City: "New York", State: "NY"}, nil, nil
+		},
+	}
+
+	uc := NewValidateAddressUsecase(repo, &mockLocalities{}, ValidateAddressConfig{})
+	resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{Address: "123 Main St, New York, NY"})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Metadata)
+	assert.Equal(t, "hit", resp.Metadata.Cache)
+}
+
+func TestValidateAddressUsecase_Explain(t *testing.T) {
+	repo := &mockRepo{
+		parseFn: func(ctx context.Context, _ string) (*entity.Address, []*entity.Address, error) {
+			entity.DecisionTraceFromContext(ctx).Addf(entity.StageParse, "zip_token", entity.ComponentPostalCode, "83702", "Took 83702 as the ZIP code")
+			return &entity.Address{
+				StreetAddress: "123 Main Street",
+				PostalCode:    "83702",
+				Evidence:      map[string]entity.Evidence{entity.ComponentPostalCode: entity.EvidenceExact},
+			}, []*entity.Address{{PostalCode: "83702"}}, nil
+		},
+	}
+	localities := &mockLocalities{byZIP: map[string]*entity.Locality{"83702": {City: "Boise", State: "ID"}}}
+	uc := NewValidateAddressUsecase(repo, localities, ValidateAddressConfig{})
+
+	t.Run("records decisions in order", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{
+			Address:       "123 Main Street 83702",
+			Mode:          "postal",
+			MinConfidence: 0.95,
+			Explain:       true,
+		})
+		require.NoError(t, err)
+
+		var rules []string
+		for _, d := range resp.Trace {
+			rules = append(rules, d.Stage+"/"+d.Rule)
+		}
+		assert.Equal(t, []string{
+			"parse/zip_token",
+			"reference/zip_locality", "reference/zip_locality",
+			"policy/required_components",
+			"confidence/evidence_score", "confidence/evidence_score", "confidence/evidence_score", "confidence/evidence_score",
+			"confidence/ambiguity_penalty",
+			"standardize/house_number", "standardize/suffix_table", "standardize/street_name",
+			"confidence/min_confidence",
+		}, rules)
+		assert.Equal(t, "Boise", resp.Trace[1].Value)
+		assert.Contains(t, resp.Trace[4].Detail, "treated as segmented")
+		assert.Equal(t, string(entity.EvidenceInferred), resp.Trace[5].Value)
+	})
+
+	t.Run("no trace unless requested", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{Address: "123 Main Street 83702", Mode: "postal"})
+		require.NoError(t, err)
+
+		assert.Nil(t, resp.Trace)
+	})
+}
+
 func TestValidateAddressUsecase_Execute(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -28,6 +124,8 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 		mockErr   error
 		expectErr bool
 		errType   string
+		errCode   domainerrors.Code
+		checkErr  func(t *testing.T, err error)
 		checkResp func(t *testing.T, resp *dto.ValidateResponse)
 	}{
 		{
@@ -47,6 +145,8 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 				assert.Equal(t, "New York", resp.Address.City)
 				assert.Equal(t, "NY", resp.Address.State)
 				assert.Equal(t, "10001", resp.Address.PostalCode)
+				assert.Equal(t, "v1|123||MAIN|ST|||NEW YORK|NY|10001", resp.Address.CanonicalKey)
+				assert.Len(t, resp.Address.CanonicalHash, 32)
 				assert.NotNil(t, resp.Confidence)
 				assert.Equal(t, "direct", resp.Confidence.StateConfidence)
 			},
@@ -56,12 +156,14 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 			input:     &dto.ValidateRequest{Address: ""},
 			expectErr: true,
 			errType:   "ValidationError",
+			errCode:   domainerrors.CodeAddressEmpty,
 		},
 		{
 			name:      "whitespace-only address returns validation error",
 			input:     &dto.ValidateRequest{Address: "   "},
 			expectErr: true,
 			errType:   "ValidationError",
+			errCode:   domainerrors.CodeAddressEmpty,
 		},
 		{
 			name:  "parser error propagated",
@@ -72,6 +174,7 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 			},
 			expectErr: true,
 			errType:   "ParsingError",
+			errCode:   domainerrors.CodeAddressUnparseable,
 		},
 		{
 			name:  "address missing required components returns parsing error",
@@ -81,6 +184,7 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 			},
 			expectErr: true,
 			errType:   "ParsingError",
+			errCode:   domainerrors.CodeComponentsInsufficient,
 		},
 		{
 			name:  "address without postal code gets inferred confidence",
@@ -97,6 +201,281 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 				assert.Equal(t, "inferred", resp.Confidence.PostalConfidence)
 			},
 		},
+		{
+			name:  "components returned with offsets into the untrimmed input",
+			input: &dto.ValidateRequest{Address: "  123 main st, new york, ny", IncludeComponents: true},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "New York",
+				State:         "NY",
+				Components: map[string]entity.TextSpan{
+					entity.ComponentStreetAddress: {Text: "123 main st", Start: 0, End: 11},
+					entity.ComponentCity:          {Text: "new york", Start: 13, End: 21},
+					entity.ComponentState:         {Text: "ny", Start: 23, End: 25},
+				},
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				require.Len(t, resp.Components, 3)
+				city := resp.Components[entity.ComponentCity]
+				assert.Equal(t, "New York", city.Value)
+				assert.Equal(t, "new york", city.Raw)
+				assert.Equal(t, 15, city.Start)
+				assert.Equal(t, 23, city.End)
+			},
+		},
+		{
+			name:  "components omitted unless requested",
+			input: &dto.ValidateRequest{Address: "123 main st, new york, ny"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "New York",
+				State:         "NY",
+				Components: map[string]entity.TextSpan{
+					entity.ComponentCity: {Text: "new york", Start: 13, End: 21},
+				},
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.Nil(t, resp.Components)
+			},
+		},
+		{
+			name:  "numeric scores come from parser evidence",
+			input: &dto.ValidateRequest{Address: "123 Main St, Springfield, Illinois 62701"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "Springfield",
+				State:         "IL",
+				PostalCode:    "62701",
+				Evidence: map[string]entity.Evidence{
+					entity.ComponentStreetAddress: entity.EvidenceExact,
+					entity.ComponentCity:          entity.EvidenceExact,
+					entity.ComponentState:         entity.EvidenceReference,
+					entity.ComponentPostalCode:    entity.EvidenceReference,
+				},
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.Equal(t, dto.StatusValid, resp.Status)
+				assert.InDelta(t, 0.9, resp.Confidence.StreetScore, 0.001)
+				assert.InDelta(t, 0.98, resp.Confidence.PostalScore, 0.001)
+				assert.InDelta(t, 0.94, resp.Confidence.Overall, 0.001)
+			},
+		},
+		{
+			name:  "result below min_confidence is unverifiable",
+			input: &dto.ValidateRequest{Address: "123 Main St Springfield IL", MinConfidence: 0.8},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "Springfield",
+				State:         "IL",
+				Evidence: map[string]entity.Evidence{
+					entity.ComponentStreetAddress: entity.EvidenceSegmented,
+					entity.ComponentCity:          entity.EvidenceSegmented,
+					entity.ComponentState:         entity.EvidenceExact,
+				},
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.True(t, resp.Success)
+				assert.Equal(t, dto.StatusUnverifiable, resp.Status)
+				assert.Less(t, resp.Confidence.Overall, 0.8)
+			},
+		},
+		{
+			name:      "min_confidence outside 0..1 returns validation error",
+			input:     &dto.ValidateRequest{Address: "123 Main St, Springfield, IL", MinConfidence: 1.5},
+			expectErr: true,
+			errType:   "ValidationError",
+			errCode:   domainerrors.CodeFieldOutOfRange,
+		},
+		{
+			name:      "unknown mode returns validation error",
+			input:     &dto.ValidateRequest{Address: "10001", Mode: "zip"},
+			expectErr: true,
+			errType:   "ValidationError",
+			errCode:   domainerrors.CodeFieldInvalid,
+		},
+		{
+			name:      "bare ZIP fails in full mode",
+			input:     &dto.ValidateRequest{Address: "83702"},
+			mockAddr:  &entity.Address{PostalCode: "83702"},
+			expectErr: true,
+			errType:   "ParsingError",
+			errCode:   domainerrors.CodeComponentsInsufficient,
+		},
+		{
+			name:     "bare ZIP resolves city and state in postal mode",
+			input:    &dto.ValidateRequest{Address: "83702", Mode: "postal"},
+			mockAddr: &entity.Address{PostalCode: "83702"},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.Equal(t, "Boise", resp.Address.City)
+				assert.Equal(t, "ID", resp.Address.State)
+				assert.Equal(t, "inferred", resp.Confidence.CityConfidence)
+				assert.Equal(t, "inferred", resp.Confidence.StateConfidence)
+				assert.InDelta(t, 0.7, resp.Confidence.CityScore, 0.001)
+				require.Len(t, resp.Warnings, 2)
+				assert.Equal(t, "COMPONENT_INFERRED", resp.Warnings[0].Code)
+				assert.Equal(t, entity.ComponentCity, resp.Warnings[0].Field)
+				assert.Equal(t, entity.ComponentState, resp.Warnings[1].Field)
+			},
+		},
+		{
+			name:      "postal mode requires a ZIP",
+			input:     &dto.ValidateRequest{Address: "Boise, ID", Mode: "postal"},
+			mockAddr:  &entity.Address{City: "Boise", State: "ID"},
+			expectErr: true,
+			errType:   "ParsingError",
+			errCode:   domainerrors.CodeComponentsInsufficient,
+		},
+		{
+			name:     "city and state accepted in locality mode",
+			input:    &dto.ValidateRequest{Address: "Boise, ID", Mode: "LOCALITY"},
+			mockAddr: &entity.Address{City: "Boise", State: "ID"},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.True(t, resp.Success)
+				assert.Equal(t, "Boise", resp.Address.City)
+			},
+		},
+		{
+			name:  "standard strictness reported by default",
+			input: &dto.ValidateRequest{Address: "123 Main St, New York, NY"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "New York",
+				State:         "NY",
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.Equal(t, "standard", resp.Strictness)
+			},
+		},
+		{
+			name:  "strict rejects address without ZIP",
+			input: &dto.ValidateRequest{Address: "123 Main St, New York, NY", Strictness: "strict"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "New York",
+				State:         "NY",
+			},
+			expectErr: true,
+			errType:   "ParsingError",
+			errCode:   domainerrors.CodeZIPRequired,
+		},
+		{
+			name:      "unknown strictness returns validation error",
+			input:     &dto.ValidateRequest{Address: "123 Main St, New York, NY", Strictness: "paranoid"},
+			expectErr: true,
+			errType:   "ValidationError",
+			errCode:   domainerrors.CodeFieldInvalid,
+		},
+		{
+			name:  "lenient drops an unknown state instead of failing",
+			input: &dto.ValidateRequest{Address: "Faketown, XX", Strictness: "lenient"},
+			mockAddr: &entity.Address{
+				City:  "Faketown",
+				State: "XX",
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.True(t, resp.Success)
+				assert.Equal(t, "lenient", resp.Strictness)
+				assert.Empty(t, resp.Address.State)
+				assert.Contains(t, resp.CorrectionsApplied, "Dropped unrecognized state XX")
+				assert.Equal(t, []*dto.CorrectionDTO{
+					{Component: entity.ComponentState, Original: "XX", Type: "dropped", Reason: "Not a USPS state code"},
+				}, resp.Corrections)
+				assert.Equal(t, dto.StatusCorrected, resp.Status)
+				require.Len(t, resp.Warnings, 1)
+				assert.Equal(t, "COMPONENT_DROPPED", resp.Warnings[0].Code)
+			},
+		},
+		{
+			name:  "bad state and bad ZIP are reported together",
+			input: &dto.ValidateRequest{Address: "123 Main St, Faketown, XX 123"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "Faketown",
+				State:         "XX",
+				PostalCode:    "123",
+			},
+			expectErr: true,
+			errType:   "ParsingError",
+			errCode:   domainerrors.CodeStateInvalid,
+			checkErr: func(t *testing.T, err error) {
+				problems := domainerrors.Problems(err)
+				require.Len(t, problems, 2)
+				assert.Equal(t, domainerrors.CodeZIPFormat, domainerrors.CodeOf(problems[1]))
+			},
+		},
+		{
+			name:  "a clean address has no warnings",
+			input: &dto.ValidateRequest{Address: "123 Main St, New York, NY 10001"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "New York",
+				State:         "NY",
+				PostalCode:    "10001",
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.Empty(t, resp.Warnings)
+			},
+		},
+		{
+			name:  "structured corrections keep a plain-text rendering",
+			input: &dto.ValidateRequest{Address: "123 main st, springfield, il"},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St",
+				City:          "Springfield",
+				State:         "IL",
+				Corrections: []entity.Correction{
+					{Component: entity.ComponentStreetAddress, Original: "123 main st", Corrected: "123 Main St", Type: entity.CorrectionCapitalization},
+					{Component: entity.ComponentCity, Original: "springfield", Corrected: "Springfield", Type: entity.CorrectionCapitalization},
+				},
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				assert.Equal(t, dto.StatusCorrected, resp.Status)
+				require.Len(t, resp.Corrections, 2)
+				assert.Equal(t, "springfield", resp.Corrections[1].Original)
+				assert.Equal(t, []string{"Standardized capitalization"}, resp.CorrectionsApplied)
+				require.Len(t, resp.Warnings, 2)
+				assert.Equal(t, "COMPONENT_CORRECTED", resp.Warnings[1].Code)
+				assert.Equal(t, "city was changed from springfield to Springfield", resp.Warnings[1].Message)
+			},
+		},
+		{
+			name:  "usps label format with a line limit",
+			input: &dto.ValidateRequest{Address: "123 Main St Apt 4, St. Louis, MO 63101-1234", Format: "usps_label", MaxLineLength: 35},
+			mockAddr: &entity.Address{
+				StreetAddress: "123 Main St Apt 4",
+				City:          "St. Louis",
+				State:         "MO",
+				PostalCode:    "63101-1234",
+			},
+			checkResp: func(t *testing.T, resp *dto.ValidateResponse) {
+				require.NotNil(t, resp.Address.Formatted)
+				assert.Equal(t, "usps_label", resp.Address.Formatted.Format)
+				assert.Equal(t, []string{"123 MAIN ST APT 4", "ST LOUIS MO 63101-1234"}, resp.Address.Formatted.Lines)
+				assert.Equal(t, "123 MAIN ST APT 4\nST LOUIS MO 63101-1234", resp.Address.Formatted.Text)
+				assert.Equal(t, "123 Main St Apt 4, St. Louis, MO 63101-1234", resp.Address.FormattedAddress)
+			},
+		},
+		{
+			name:      "unknown format returns validation error",
+			input:     &dto.ValidateRequest{Address: "123 Main St, New York, NY", Format: "postcard"},
+			expectErr: true,
+			errType:   "ValidationError",
+			errCode:   domainerrors.CodeFieldInvalid,
+		},
+		{
+			name:      "max_line_length without a format returns validation error",
+			input:     &dto.ValidateRequest{Address: "123 Main St, New York, NY", MaxLineLength: 35},
+			expectErr: true,
+			errType:   "ValidationError",
+			errCode:   domainerrors.CodeFieldRequired,
+		},
+		{
+			name:      "max_line_length below the minimum returns validation error",
+			input:     &dto.ValidateRequest{Address: "123 Main St, New York, NY", Format: "multi_line", MaxLineLength: 10},
+			expectErr: true,
+			errType:   "ValidationError",
+			errCode:   domainerrors.CodeFieldOutOfRange,
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,7 +489,11 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 				},
 			}
 
-			uc := NewValidateAddressUsecase(repo)
+			localities := &mockLocalities{byZIP: map[string]*entity.Locality{
+				"83702": {PostalCode: "83702", City: "Boise", State: "ID"},
+			}}
+
+			uc := NewValidateAddressUsecase(repo, localities, ValidateAddressConfig{})
 			resp, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.expectErr {
@@ -123,6 +506,10 @@ func TestValidateAddressUsecase_Execute(t *testing.T) {
 					var pe *domainerrors.ParsingError
 					assert.ErrorAs(t, err, &pe)
 				}
+				assert.Equal(t, tt.errCode, domainerrors.CodeOf(err))
+				if tt.checkErr != nil {
+					tt.checkErr(t, err)
+				}
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, resp)
@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// csvFlushEvery bounds how many output rows are buffered before they are written out.
+const csvFlushEvery = 50
+
+// csvStatusError marks rows the validation pipeline rejected; the errors column says why.
+const csvStatusError = "error"
+
+// csvOutputColumns are appended to every row after the original columns.
+var csvOutputColumns = []string{
+	"normalized_street",
+	"normalized_city",
+	"normalized_state",
+	"normalized_postal_code",
+	"validation_status",
+	"confidence",
+	"corrections",
+	"errors",
+}
+
+//go:generate mockgen -destination=validate_csv_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ValidateCSVUsecaseInterface
+type ValidateCSVUsecaseInterface interface {
+	Execute(ctx context.Context, in io.Reader, out io.Writer, input *dto.ValidateCSVRequest, lang i18n.Language) (int, error)
+}
+
+// ValidateCSVUsecase validates every row of a CSV file and writes the annotated rows back out.
+type ValidateCSVUsecase struct {
+	validator         ValidateAddressUsecaseInterface
+	defaultStrictness entity.Strictness
+}
+
+// NewValidateCSVUsecase creates a new ValidateCSVUsecase. defaultStrictness must match the
+// validator's so the options are checked the same way; empty means standard.
+func NewValidateCSVUsecase(validator ValidateAddressUsecaseInterface, defaultStrictness entity.Strictness) *ValidateCSVUsecase {
+	if defaultStrictness == "" {
+		defaultStrictness = entity.StrictnessStandard
+	}
+	return &ValidateCSVUsecase{validator: validator, defaultStrictness: defaultStrictness}
+}
+
+// Execute reads the header row and then one row at a time, writing each annotated row as
+// soon as it is validated, so files of any size use constant memory. It reports how many
+// rows were written.
+//
+// Problems with the mapping, the options or the header are returned before anything is
+// written. Rows the pipeline rejects are written with validation_status "error" and
+// their errors in lang; only a malformed CSV, a failed write or a cancelled ctx stop
+// the output part way.
+func (uc *ValidateCSVUsecase) Execute(ctx context.Context, in io.Reader, out io.Writer, input *dto.ValidateCSVRequest, lang i18n.Language) (int, error) {
+	options := &dto.ValidateRequest{
+		MinConfidence: input.MinConfidence,
+		Mode:          input.Mode,
+		Strictness:    input.Strictness,
+	}
+	if _, err := resolveOptions(options, uc.defaultStrictness); err != nil {
+		return 0, err
+	}
+
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return 0, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldRequired,
+			Field:      "file",
+			Reason:     "CSV file is empty",
+			Suggestion: "Upload a CSV with a header row",
+		}
+	}
+	if err != nil {
+		return 0, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeRequestMalformed,
+			Field:      "file",
+			Reason:     "CSV header could not be read: " + err.Error(),
+			Suggestion: "Upload a comma-separated file with a header row",
+		}
+	}
+	// Spreadsheet exports often start with a UTF-8 byte order mark.
+	header[0] = strings.TrimPrefix(header[0], "\uFEFF")
+
+	mapping, err := newCSVMapping(header, input)
+	if err != nil {
+		return 0, err
+	}
+
+	writer := csv.NewWriter(out)
+	if err := writer.Write(append(csvSafe(header), csvOutputColumns...)); err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return rows, err
+		}
+
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			writer.Flush()
+			return rows, err
+		}
+
+		request := *options
+		request.Address = mapping.address(record)
+		resp, err := uc.validator.Execute(ctx, &request)
+		if err != nil {
+			resp = dto.NewErrorResponse(err)
+		}
+		resp = lang.ValidateResponse(resp)
+
+		if err := writer.Write(append(csvSafe(record), csvResultColumns(resp)...)); err != nil {
+			return rows, err
+		}
+		rows++
+
+		if rows%csvFlushEvery == 0 {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return rows, err
+			}
+		}
+	}
+
+	writer.Flush()
+	return rows, writer.Error()
+}
+
+// csvMapping holds the header positions of the mapped columns; -1 means unmapped.
+type csvMapping struct {
+	column int
+	parts  []int
+}
+
+func newCSVMapping(header []string, input *dto.ValidateCSVRequest) (*csvMapping, error) {
+	components := []struct{ field, name string }{
+		{"street_column", input.StreetColumn},
+		{"street2_column", input.Street2Column},
+		{"city_column", input.CityColumn},
+		{"state_column", input.StateColumn},
+		{"postal_code_column", input.PostalCodeColumn},
+	}
+
+	hasComponents := false
+	for _, c := range components {
+		hasComponents = hasComponents || c.name != ""
+	}
+
+	switch {
+	case input.AddressColumn != "" && hasComponents:
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldInvalid,
+			Field:      "address_column",
+			Reason:     "address_column cannot be combined with component columns",
+			Suggestion: "Map either one address column or the separate component columns",
+		}
+	case input.AddressColumn == "" && !hasComponents:
+		return nil, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeFieldRequired,
+			Field:      "address_column",
+			Reason:     "no address columns are mapped",
+			Suggestion: "Set address_column, or street_column, city_column, state_column and postal_code_column",
+		}
+	}
+
+	mapping := &csvMapping{column: -1}
+	if input.AddressColumn != "" {
+		index, err := csvColumnIndex(header, "address_column", input.AddressColumn)
+		if err != nil {
+			return nil, err
+		}
+		mapping.column = index
+		return mapping, nil
+	}
+
+	for _, c := range components {
+		if c.name == "" {
+			mapping.parts = append(mapping.parts, -1)
+			continue
+		}
+		index, err := csvColumnIndex(header, c.field, c.name)
+		if err != nil {
+			return nil, err
+		}
+		mapping.parts = append(mapping.parts, index)
+	}
+	return mapping, nil
+}
+
+// csvColumnIndex finds name in the header, ignoring case and surrounding space.
+func csvColumnIndex(header []string, field, name string) (int, error) {
+	for i, column := range header {
+		if strings.EqualFold(strings.TrimSpace(column), strings.TrimSpace(name)) {
+			return i, nil
+		}
+	}
+	return -1, &domainerrors.ValidationError{
+		Code:       domainerrors.CodeFieldInvalid,
+		Field:      field,
+		Reason:     "column not found in the CSV header",
+		Value:      name,
+		Suggestion: "Use a column name from the file's first row",
+	}
+}
+
+// address builds the address for a row: the mapped column as-is, or the components
+// as "street street2, city, state postal_code".
+func (m *csvMapping) address(record []string) string {
+	if m.column >= 0 {
+		return csvField(record, m.column)
+	}
+
+	field := func(i int) string { return csvField(record, m.parts[i]) }
+	region := strings.TrimSpace(field(3) + " " + field(4))
+
+	var parts []string
+	for _, part := range []string{strings.TrimSpace(field(0) + " " + field(1)), field(2), region} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// csvField returns the trimmed value at index, or "" when the row is short or index is unmapped.
+func csvField(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
+
+// numericCell matches numbers and phone numbers such as "-5" or "+1 (555) 010-0100":
+// they start with + or -, but hold nothing a spreadsheet could run.
+var numericCell = regexp.MustCompile(`^[+-][\d\s().,-]*$`)
+
+// csvSafe returns a copy of cells in which those a spreadsheet would run as a formula
+// get a leading apostrophe, which spreadsheets display as text. That is cells starting
+// with =, @, a tab or a carriage return, and cells starting with + or - unless they are
+// numeric. It applies to text from the upload: its cells and the components echoing them.
+func csvSafe(cells []string) []string {
+	safe := make([]string, len(cells))
+	for i, cell := range cells {
+		safe[i] = cell
+		if cell == "" {
+			continue
+		}
+		switch cell[0] {
+		case '=', '@', '\t', '\r':
+			safe[i] = "'" + cell
+		case '+', '-':
+			if !numericCell.MatchString(cell) {
+				safe[i] = "'" + cell
+			}
+		}
+	}
+	return safe
+}
+
+func csvResultColumns(resp *dto.ValidateResponse) []string {
+	columns := make([]string, len(csvOutputColumns))
+
+	if resp.Address != nil {
+		copy(columns, csvSafe([]string{
+			resp.Address.StreetAddress,
+			resp.Address.City,
+			resp.Address.State,
+			resp.Address.PostalCode,
+		}))
+	}
+
+	columns[4] = resp.Status
+	if !resp.Success {
+		columns[4] = csvStatusError
+	}
+
+	if resp.Confidence != nil {
+		columns[5] = strconv.FormatFloat(resp.Confidence.Overall, 'f', 2, 64)
+	}
+	columns[6] = strings.Join(resp.CorrectionsApplied, "; ")
+
+	problems := make([]string, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		problems = append(problems, e.Field+": "+e.Reason)
+	}
+	columns[7] = strings.Join(problems, "; ")
+
+	return columns
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: ValidateCSVUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=validate_csv_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ValidateCSVUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	i18n "github.com/williandandrade/address-validation-service/internal/api/i18n"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValidateCSVUsecaseInterface is a mock of ValidateCSVUsecaseInterface interface.
+type MockValidateCSVUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidateCSVUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockValidateCSVUsecaseInterfaceMockRecorder is the mock recorder for MockValidateCSVUsecaseInterface.
+type MockValidateCSVUsecaseInterfaceMockRecorder struct {
+	mock *MockValidateCSVUsecaseInterface
+}
+
+// NewMockValidateCSVUsecaseInterface creates a new mock instance.
+func NewMockValidateCSVUsecaseInterface(ctrl *gomock.Controller) *MockValidateCSVUsecaseInterface {
+	mock := &MockValidateCSVUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockValidateCSVUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidateCSVUsecaseInterface) EXPECT() *MockValidateCSVUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockValidateCSVUsecaseInterface) Execute(ctx context.Context, in io.Reader, out io.Writer, input *dto.ValidateCSVRequest, lang i18n.Language) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in, out, input, lang)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockValidateCSVUsecaseInterfaceMockRecorder) Execute(ctx, in, out, input, lang any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockValidateCSVUsecaseInterface)(nil).Execute), ctx, in, out, input, lang)
+}
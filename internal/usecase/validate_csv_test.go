@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// echoValidator answers every non-empty address as corrected, echoing it as the street.
+func echoValidator(ctrl *gomock.Controller) *MockValidateAddressUsecaseInterface {
+	validator := NewMockValidateAddressUsecaseInterface(ctrl)
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			if input.Address == "" {
+				return nil, &domainerrors.ValidationError{Field: "address", Reason: "address field is required and cannot be empty"}
+			}
+			return &dto.ValidateResponse{
+				Success:            true,
+				Status:             dto.StatusCorrected,
+				Address:            &dto.AddressDTO{StreetAddress: input.Address, City: "Springfield", State: "IL", PostalCode: "62701"},
+				Confidence:         &dto.ConfidenceDTO{Overall: 0.875},
+				CorrectionsApplied: []string{"city: springfield -> Springfield", "state: illinois -> IL"},
+			}, nil
+		}).AnyTimes()
+	return validator
+}
+
+func TestValidateCSVUsecase_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		input    *dto.ValidateCSVRequest
+		expected string
+	}{
+		{
+			name:  "address column keeps the original columns",
+			csv:   "\uFEFFid,Address\n1,\"123 Main St, Springfield, IL\"\n2,\n",
+			input: &dto.ValidateCSVRequest{AddressColumn: "address"},
+			expected: "id,Address,normalized_street,normalized_city,normalized_state,normalized_postal_code,validation_status,confidence,corrections,errors\n" +
+				"1,\"123 Main St, Springfield, IL\",\"123 Main St, Springfield, IL\",Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n" +
+				"2,,,,,,error,,,address: address field is required and cannot be empty\n",
+		},
+		{
+			name:  "component columns are joined into one address",
+			csv:   "street,unit,city,state,zip\n123 Main St,Apt 4,Springfield,IL,62701\n456 Oak Ave,,,ID,83702\n",
+			input: &dto.ValidateCSVRequest{StreetColumn: "street", Street2Column: "unit", CityColumn: "city", StateColumn: "state", PostalCodeColumn: "zip"},
+			expected: "street,unit,city,state,zip,normalized_street,normalized_city,normalized_state,normalized_postal_code,validation_status,confidence,corrections,errors\n" +
+				"123 Main St,Apt 4,Springfield,IL,62701,\"123 Main St Apt 4, Springfield, IL 62701\",Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n" +
+				"456 Oak Ave,,,ID,83702,\"456 Oak Ave, ID 83702\",Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n",
+		},
+		{
+			name:  "cells a spreadsheet would run as formulas are neutralized",
+			csv:   "id,address,@note\n-1,\"=HYPERLINK(\"\"http://evil.example\"\")\",+cmd\n2,\"\t123 Main St\",\"\rx\"\n",
+			input: &dto.ValidateCSVRequest{AddressColumn: "address"},
+			expected: "id,address,'@note,normalized_street,normalized_city,normalized_state,normalized_postal_code,validation_status,confidence,corrections,errors\n" +
+				"-1,\"'=HYPERLINK(\"\"http://evil.example\"\")\",'+cmd,\"'=HYPERLINK(\"\"http://evil.example\"\")\",Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n" +
+				"2,'\t123 Main St,\"'\rx\",123 Main St,Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n",
+		},
+		{
+			name:  "numbers and phone numbers are left as they are",
+			csv:   "amount,phone,note,address\n-5,+1 555 010 0100,-,123 Main St\n+2.5,(555) 010-0100,-A1,456 Oak Ave\n",
+			input: &dto.ValidateCSVRequest{AddressColumn: "address"},
+			expected: "amount,phone,note,address,normalized_street,normalized_city,normalized_state,normalized_postal_code,validation_status,confidence,corrections,errors\n" +
+				"-5,+1 555 010 0100,-,123 Main St,123 Main St,Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n" +
+				"+2.5,(555) 010-0100,'-A1,456 Oak Ave,456 Oak Ave,Springfield,IL,62701,corrected,0.88,city: springfield -> Springfield; state: illinois -> IL,\n",
+		},
+		{
+			name:     "header only",
+			csv:      "address\n",
+			input:    &dto.ValidateCSVRequest{AddressColumn: "address"},
+			expected: "address,normalized_street,normalized_city,normalized_state,normalized_postal_code,validation_status,confidence,corrections,errors\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewValidateCSVUsecase(echoValidator(gomock.NewController(t)), "")
+
+			var out bytes.Buffer
+			rows, err := uc.Execute(context.Background(), strings.NewReader(tt.csv), &out, tt.input, i18n.English)
+
+			require.NoError(t, err)
+			assert.Equal(t, strings.Count(tt.expected, "\n")-1, rows)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestCSVSafe(t *testing.T) {
+	cells := []string{"=1+1", "+1 555 010 0100", "-5", "-", "+cmd", "@note", "Main St", ""}
+
+	safe := csvSafe(cells)
+
+	assert.Equal(t, []string{"'=1+1", "+1 555 010 0100", "-5", "-", "'+cmd", "'@note", "Main St", ""}, safe)
+	assert.Equal(t, "=1+1", cells[0], "the caller's cells are not modified")
+}
+
+func TestValidateCSVUsecase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		csv         string
+		input       *dto.ValidateCSVRequest
+		expectField string
+	}{
+		{
+			name:        "nothing mapped",
+			csv:         "address\n",
+			input:       &dto.ValidateCSVRequest{},
+			expectField: "address_column",
+		},
+		{
+			name:        "address and component columns",
+			csv:         "address,zip\n",
+			input:       &dto.ValidateCSVRequest{AddressColumn: "address", PostalCodeColumn: "zip"},
+			expectField: "address_column",
+		},
+		{
+			name:        "unknown column",
+			csv:         "street,city\n",
+			input:       &dto.ValidateCSVRequest{StreetColumn: "street", CityColumn: "town"},
+			expectField: "city_column",
+		},
+		{
+			name:        "empty file",
+			csv:         "",
+			input:       &dto.ValidateCSVRequest{AddressColumn: "address"},
+			expectField: "file",
+		},
+		{
+			name:        "invalid options",
+			csv:         "address\n",
+			input:       &dto.ValidateCSVRequest{AddressColumn: "address", Mode: "zip"},
+			expectField: "mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewValidateCSVUsecase(NewMockValidateAddressUsecaseInterface(gomock.NewController(t)), "")
+
+			var out bytes.Buffer
+			_, err := uc.Execute(context.Background(), strings.NewReader(tt.csv), &out, tt.input, i18n.English)
+
+			var ve *domainerrors.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.expectField, ve.Field)
+			assert.Empty(t, out.String(), "nothing is written before the mapping is checked")
+		})
+	}
+}
+
+func TestValidateCSVUsecase_Execute_Streams(t *testing.T) {
+	var input strings.Builder
+	input.WriteString("address\n")
+	for range csvFlushEvery + 1 {
+		input.WriteString("123 Main St\n")
+	}
+	input.WriteString("\"unterminated\n")
+
+	uc := NewValidateCSVUsecase(echoValidator(gomock.NewController(t)), "")
+
+	var out bytes.Buffer
+	rows, err := uc.Execute(context.Background(), strings.NewReader(input.String()), &out, &dto.ValidateCSVRequest{AddressColumn: "address"}, i18n.English)
+
+	require.Error(t, err, "a malformed row stops the output")
+	assert.Equal(t, csvFlushEvery+1, rows)
+	assert.Equal(t, csvFlushEvery+2, strings.Count(out.String(), "\n"), "rows before the failure are written")
+}
+
+func TestValidateCSVUsecase_Execute_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	uc := NewValidateCSVUsecase(NewMockValidateAddressUsecaseInterface(gomock.NewController(t)), "")
+
+	var out bytes.Buffer
+	_, err := uc.Execute(ctx, strings.NewReader("address\n123 Main St\n"), &out, &dto.ValidateCSVRequest{AddressColumn: "address"}, i18n.English)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestValidateCSVUsecase_Execute_Localized(t *testing.T) {
+	validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, &domainerrors.ValidationError{
+		Code:   domainerrors.CodeAddressEmpty,
+		Field:  "address",
+		Reason: "address field is required and cannot be empty",
+	})
+
+	var out bytes.Buffer
+	_, err := NewValidateCSVUsecase(validator, "").Execute(context.Background(), strings.NewReader("address\n\n\"\"\n"), &out, &dto.ValidateCSVRequest{AddressColumn: "address"}, i18n.Spanish)
+
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), ",error,,,address: La dirección es obligatoria y no puede estar vacía\n")
+}
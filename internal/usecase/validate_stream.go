@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+const (
+	// defaultStreamConcurrency is used when no concurrency is configured.
+	defaultStreamConcurrency = 8
+	// maxStreamLineBytes bounds one input line; a request line is a few hundred bytes.
+	maxStreamLineBytes = 64 << 10
+)
+
+//go:generate mockgen -destination=validate_stream_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ValidateStreamUsecaseInterface
+type ValidateStreamUsecaseInterface interface {
+	Execute(ctx context.Context, in io.Reader, out io.Writer, correlationID string, lang i18n.Language) (int, error)
+	Stream(ctx context.Context, receive func() (*dto.StreamValidateRequest, error), send func(*dto.StreamValidateResult, error) error) (int, error)
+}
+
+// ValidateStreamUsecase validates streams of requests with bounded concurrency.
+type ValidateStreamUsecase struct {
+	validator   ValidateAddressUsecaseInterface
+	concurrency int
+}
+
+// NewValidateStreamUsecase creates a new ValidateStreamUsecase validating up to
+// concurrency requests at a time; zero or less uses the default of 8.
+func NewValidateStreamUsecase(validator ValidateAddressUsecaseInterface, concurrency int) *ValidateStreamUsecase {
+	if concurrency <= 0 {
+		concurrency = defaultStreamConcurrency
+	}
+	return &ValidateStreamUsecase{validator: validator, concurrency: concurrency}
+}
+
+// Execute reads one dto.StreamValidateRequest per line and writes one
+// dto.StreamValidateResult per line as each finishes, and reports how many it wrote.
+// Blank lines are skipped and lines that are not valid JSON get an error result. Each
+// result's messages, errors and warnings are written in lang.
+//
+// The next line is only read once a validation slot is free, and a slot is only freed
+// once its result is written, so a slow reader on either side slows the whole stream
+// instead of buffering it. Only an oversized line, a failed write or a cancelled ctx
+// stop the stream early.
+func (uc *ValidateStreamUsecase) Execute(ctx context.Context, in io.Reader, out io.Writer, correlationID string, lang i18n.Language) (int, error) {
+	encoder := json.NewEncoder(out)
+	send := func(result *dto.StreamValidateResult, err error) error {
+		if err != nil {
+			result.Result = dto.NewErrorResponse(err)
+		}
+		result.Result = lang.ValidateResponse(result.Result)
+		return encoder.Encode(result)
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 4096), maxStreamLineBytes)
+
+	line := 0
+	next := func() (streamItem, bool) {
+		for scanner.Scan() {
+			line++
+			raw := bytes.TrimSpace(scanner.Bytes())
+			if len(raw) == 0 {
+				continue
+			}
+
+			result := &dto.StreamValidateResult{CorrelationID: correlationID, Line: line}
+			request := new(dto.StreamValidateRequest)
+			if err := json.Unmarshal(raw, request); err != nil {
+				return streamItem{result: result, err: &domainerrors.ValidationError{
+					Code:       domainerrors.CodeRequestMalformed,
+					Field:      "line",
+					Reason:     "line is not a valid JSON request: " + err.Error(),
+					Suggestion: `Send one JSON object per line, e.g. {"id":"1","address":"123 Main St, Boise, ID"}`,
+				}}, true
+			}
+			result.ID = request.ID
+			return streamItem{request: &request.ValidateRequest, result: result}, true
+		}
+		return streamItem{}, false
+	}
+
+	written, err := uc.pump(ctx, next, send)
+	if err != nil {
+		return written, err
+	}
+	if err := scanner.Err(); errors.Is(err, bufio.ErrTooLong) {
+		return written, &domainerrors.ValidationError{
+			Code:       domainerrors.CodeLimitExceeded,
+			Field:      "line",
+			Reason:     "line exceeds the maximum length of 65536 bytes",
+			Value:      line + 1,
+			Suggestion: "Send one address request per line",
+		}
+	} else if err != nil {
+		return written, err
+	}
+	return written, ctx.Err()
+}
+
+// Stream validates the requests receive returns and passes each outcome to send as
+// it finishes, with the request's 1-based position as the result's Line. A failed
+// validation is passed as the error, with a nil result.Result. It reports how many
+// results were sent.
+//
+// Like Execute, the next request is only received once a validation slot is free.
+// Stream returns once receive returns io.EOF and every result is sent; any other
+// error from receive or send, or a cancelled ctx, stops it early.
+func (uc *ValidateStreamUsecase) Stream(
+	ctx context.Context,
+	receive func() (*dto.StreamValidateRequest, error),
+	send func(*dto.StreamValidateResult, error) error,
+) (int, error) {
+	var (
+		sequence   int
+		receiveErr error
+	)
+	next := func() (streamItem, bool) {
+		request, err := receive()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				receiveErr = err
+			}
+			return streamItem{}, false
+		}
+
+		sequence++
+		return streamItem{
+			request: &request.ValidateRequest,
+			result:  &dto.StreamValidateResult{ID: request.ID, Line: sequence},
+		}, true
+	}
+
+	written, err := uc.pump(ctx, next, send)
+	if err != nil {
+		return written, err
+	}
+	if receiveErr != nil {
+		return written, receiveErr
+	}
+	return written, ctx.Err()
+}
+
+// streamItem is one input of a stream: a request to validate, or the error for an
+// input that could not be read as one.
+type streamItem struct {
+	request *dto.ValidateRequest
+	result  *dto.StreamValidateResult
+	err     error
+}
+
+// pump validates the items next returns, up to uc.concurrency at a time, until it
+// reports no more, and sends each outcome as it finishes. Sends never overlap.
+func (uc *ValidateStreamUsecase) pump(
+	ctx context.Context,
+	next func() (streamItem, bool),
+	send func(*dto.StreamValidateResult, error) error,
+) (int, error) {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(uc.concurrency)
+
+	var (
+		mu      sync.Mutex
+		written int
+	)
+	emit := func(result *dto.StreamValidateResult, err error) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err := send(result, err); err != nil {
+			return err
+		}
+		written++
+		return nil
+	}
+
+	for groupCtx.Err() == nil {
+		item, ok := next()
+		if !ok {
+			break
+		}
+		if item.request == nil {
+			group.Go(func() error { return emit(item.result, item.err) })
+			continue
+		}
+
+		group.Go(func() error {
+			resp, err := uc.validator.Execute(groupCtx, item.request)
+			item.result.Result = resp
+			return emit(item.result, err)
+		})
+	}
+
+	err := group.Wait()
+	return written, err
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: ValidateStreamUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=validate_stream_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase ValidateStreamUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	i18n "github.com/williandandrade/address-validation-service/internal/api/i18n"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValidateStreamUsecaseInterface is a mock of ValidateStreamUsecaseInterface interface.
+type MockValidateStreamUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidateStreamUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockValidateStreamUsecaseInterfaceMockRecorder is the mock recorder for MockValidateStreamUsecaseInterface.
+type MockValidateStreamUsecaseInterfaceMockRecorder struct {
+	mock *MockValidateStreamUsecaseInterface
+}
+
+// NewMockValidateStreamUsecaseInterface creates a new mock instance.
+func NewMockValidateStreamUsecaseInterface(ctrl *gomock.Controller) *MockValidateStreamUsecaseInterface {
+	mock := &MockValidateStreamUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockValidateStreamUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidateStreamUsecaseInterface) EXPECT() *MockValidateStreamUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockValidateStreamUsecaseInterface) Execute(ctx context.Context, in io.Reader, out io.Writer, correlationID string, lang i18n.Language) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in, out, correlationID, lang)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockValidateStreamUsecaseInterfaceMockRecorder) Execute(ctx, in, out, correlationID, lang any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockValidateStreamUsecaseInterface)(nil).Execute), ctx, in, out, correlationID, lang)
+}
+
+// Stream mocks base method.
+func (m *MockValidateStreamUsecaseInterface) Stream(ctx context.Context, receive func() (*dto.StreamValidateRequest, error), send func(*dto.StreamValidateResult, error) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, receive, send)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockValidateStreamUsecaseInterfaceMockRecorder) Stream(ctx, receive, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockValidateStreamUsecaseInterface)(nil).Stream), ctx, receive, send)
+}
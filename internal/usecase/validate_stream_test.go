@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/api/i18n"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+func decodeStreamResults(t *testing.T, out string) map[int]*dto.StreamValidateResult {
+	t.Helper()
+
+	results := map[int]*dto.StreamValidateResult{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		var result dto.StreamValidateResult
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &result))
+		results[result.Line] = &result
+	}
+	return results
+}
+
+func TestValidateStreamUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	validator := NewMockValidateAddressUsecaseInterface(ctrl)
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			if input.Address == "" {
+				return nil, &domainerrors.ValidationError{Field: "address", Reason: "address field is required and cannot be empty"}
+			}
+			return &dto.ValidateResponse{Success: true, Status: dto.StatusValid, Strictness: input.Strictness}, nil
+		}).Times(3)
+
+	in := strings.Join([]string{
+		`{"id":"a","address":"123 Main St, Springfield, IL","strictness":"lenient"}`,
+		``,
+		`{"id":42,"address":""}`,
+		`{"address":"83702","mode":"postal"}`,
+		`not json`,
+	}, "\n")
+
+	var out bytes.Buffer
+	written, err := NewValidateStreamUsecase(validator, 2).Execute(context.Background(), strings.NewReader(in), &out, "batch-7", i18n.English)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, written)
+
+	results := decodeStreamResults(t, out.String())
+	require.Len(t, results, 4, "blank lines produce no result")
+	for _, result := range results {
+		assert.Equal(t, "batch-7", result.CorrelationID)
+	}
+
+	assert.JSONEq(t, `"a"`, string(results[1].ID))
+	assert.Equal(t, "lenient", results[1].Result.Strictness)
+
+	assert.JSONEq(t, `42`, string(results[3].ID))
+	assert.False(t, results[3].Result.Success)
+	assert.Equal(t, "address", results[3].Result.Errors[0].Field)
+
+	assert.Nil(t, results[4].ID)
+	assert.True(t, results[4].Result.Success)
+
+	assert.False(t, results[5].Result.Success)
+	assert.Equal(t, "line", results[5].Result.Errors[0].Field)
+}
+
+func TestValidateStreamUsecase_Execute_BoundsConcurrency(t *testing.T) {
+	const limit = 2
+
+	var inFlight, maxInFlight atomic.Int32
+	release := make(chan struct{})
+
+	ctrl := gomock.NewController(t)
+	validator := NewMockValidateAddressUsecaseInterface(ctrl)
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			n := inFlight.Add(1)
+			for {
+				current := maxInFlight.Load()
+				if n <= current || maxInFlight.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			<-release
+			inFlight.Add(-1)
+			return &dto.ValidateResponse{Success: true}, nil
+		}).Times(5)
+
+	// Each pipe write blocks until the stream reads it, showing how far the input was read.
+	in, inWriter := io.Pipe()
+	var linesSent atomic.Int32
+	go func() {
+		for range 5 {
+			if _, err := io.WriteString(inWriter, `{"address":"123 Main St"}`+"\n"); err != nil {
+				return
+			}
+			linesSent.Add(1)
+		}
+		inWriter.Close()
+	}()
+
+	var (
+		out  bytes.Buffer
+		wg   sync.WaitGroup
+		err  error
+		rows int
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		rows, err = NewValidateStreamUsecase(validator, limit).Execute(context.Background(), in, &out, "", i18n.English)
+	}()
+
+	require.Eventually(t, func() bool { return inFlight.Load() == limit }, time.Second, time.Millisecond)
+	assert.Never(t, func() bool { return linesSent.Load() > limit+1 }, 50*time.Millisecond, 5*time.Millisecond,
+		"input is not read while every slot is busy")
+
+	close(release)
+	wg.Wait()
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, rows)
+	assert.Equal(t, int32(limit), maxInFlight.Load())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestValidateStreamUsecase_Execute_Errors(t *testing.T) {
+	t.Run("oversized line stops the stream", func(t *testing.T) {
+		in := `{"address":"` + strings.Repeat("x", maxStreamLineBytes) + `"}`
+
+		_, err := NewValidateStreamUsecase(NewMockValidateAddressUsecaseInterface(gomock.NewController(t)), 0).
+			Execute(context.Background(), strings.NewReader(in), io.Discard, "", i18n.English)
+
+		var ve *domainerrors.ValidationError
+		require.ErrorAs(t, err, &ve)
+		assert.Equal(t, "line", ve.Field)
+		assert.Equal(t, 1, ve.Value)
+	})
+
+	t.Run("write failure stops the stream", func(t *testing.T) {
+		validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+		validator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&dto.ValidateResponse{Success: true}, nil).AnyTimes()
+		in := strings.Repeat(`{"address":"123 Main St"}`+"\n", 100)
+
+		written, err := NewValidateStreamUsecase(validator, 1).Execute(context.Background(), strings.NewReader(in), failingWriter{}, "", i18n.English)
+
+		assert.EqualError(t, err, "connection reset")
+		assert.Zero(t, written)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := NewValidateStreamUsecase(NewMockValidateAddressUsecaseInterface(gomock.NewController(t)), 0).
+			Execute(ctx, strings.NewReader(`{"address":"123 Main St"}`), io.Discard, "", i18n.English)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestValidateStreamUsecase_Stream(t *testing.T) {
+	validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+	validator.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *dto.ValidateRequest) (*dto.ValidateResponse, error) {
+			if input.Address == "" {
+				return nil, &domainerrors.ValidationError{Field: "address", Reason: "address field is required and cannot be empty"}
+			}
+			return &dto.ValidateResponse{Success: true, Status: dto.StatusValid}, nil
+		}).Times(3)
+
+	requests := []*dto.StreamValidateRequest{
+		{ID: json.RawMessage(`"a"`), ValidateRequest: dto.ValidateRequest{Address: "123 Main St, Springfield, IL"}},
+		{ValidateRequest: dto.ValidateRequest{Address: ""}},
+		{ID: json.RawMessage(`"c"`), ValidateRequest: dto.ValidateRequest{Address: "83702", Mode: "postal"}},
+	}
+	receive := func() (*dto.StreamValidateRequest, error) {
+		if len(requests) == 0 {
+			return nil, io.EOF
+		}
+		request := requests[0]
+		requests = requests[1:]
+		return request, nil
+	}
+
+	results := map[int]*dto.StreamValidateResult{}
+	errs := map[int]error{}
+	send := func(result *dto.StreamValidateResult, err error) error {
+		results[result.Line] = result
+		errs[result.Line] = err
+		return nil
+	}
+
+	sent, err := NewValidateStreamUsecase(validator, 2).Stream(context.Background(), receive, send)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, sent)
+	require.Len(t, results, 3)
+
+	assert.JSONEq(t, `"a"`, string(results[1].ID))
+	assert.True(t, results[1].Result.Success)
+	assert.NoError(t, errs[1])
+
+	assert.Nil(t, results[2].Result)
+	var ve *domainerrors.ValidationError
+	require.ErrorAs(t, errs[2], &ve)
+	assert.Equal(t, "address", ve.Field)
+
+	assert.JSONEq(t, `"c"`, string(results[3].ID))
+
+	t.Run("receive failure stops the stream", func(t *testing.T) {
+		receive := func() (*dto.StreamValidateRequest, error) { return nil, errors.New("stream reset") }
+
+		_, err := NewValidateStreamUsecase(validator, 1).Stream(context.Background(), receive, send)
+
+		assert.EqualError(t, err, "stream reset")
+	})
+}
+
+func TestValidateStreamUsecase_Execute_Localized(t *testing.T) {
+	validator := NewMockValidateAddressUsecaseInterface(gomock.NewController(t))
+
+	var out bytes.Buffer
+	written, err := NewValidateStreamUsecase(validator, 1).Execute(context.Background(), strings.NewReader("not json\n"), &out, "", i18n.Spanish)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, written)
+	result := decodeStreamResults(t, out.String())[1]
+	assert.Equal(t, "No se pudo leer la solicitud", result.Result.Errors[0].Reason)
+	assert.Equal(t, "Revise el formato del cuerpo de la solicitud", result.Result.Errors[0].Suggestion)
+}
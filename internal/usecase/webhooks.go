@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// Headers sent with every webhook. The signature is "t=<unix seconds>,v1=<hex>", where
+// v1 is the HMAC-SHA256 of "<unix seconds>.<body>" under the tenant's secret.
+const (
+	HeaderWebhookID        = "X-Webhook-ID"
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+const (
+	// dueDeliveriesBatch bounds how many deliveries one DeliverWebhooksUsecase run attempts.
+	dueDeliveriesBatch = 50
+	// defaultDeliveryLease is how long a claim holds when WebhookConfig.Lease is unset.
+	defaultDeliveryLease = 10 * time.Minute
+)
+
+// WebhookConfig holds the tenant secrets and retry policy for webhook delivery.
+type WebhookConfig struct {
+	// Secrets maps each tenant to the secret its webhooks are signed with.
+	Secrets map[string]string
+	// MaxAttempts is how many failed attempts move a delivery to dead.
+	MaxAttempts int
+	// BaseBackoff is the wait after the first failure; it doubles per attempt up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Lease is how long a deliverer holds the deliveries it claims; another deliverer may
+	// retry them once it runs out. It must outlast a batch of attempts.
+	Lease time.Duration
+}
+
+// SignWebhook returns the signature header value for body sent at timestamp.
+func SignWebhook(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookNotifier builds the webhooks a job owes. RunJobsUsecase stores each with the
+// result it reports, and DeliverWebhooksUsecase sends them.
+type WebhookNotifier struct {
+	now func() time.Time
+}
+
+// NewWebhookNotifier creates a new WebhookNotifier.
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{now: time.Now}
+}
+
+// JobCompleted returns the job.completed webhook for job in its final state, or nil if
+// the job has no callback.
+func (n *WebhookNotifier) JobCompleted(job *entity.Job) (*entity.WebhookDelivery, error) {
+	if !job.Callback.Wants(entity.EventJobCompleted) {
+		return nil, nil
+	}
+	return n.delivery(job, &dto.WebhookPayload{
+		Event: string(entity.EventJobCompleted),
+		JobID: job.ID,
+		Job:   mapJobToDTO(job),
+	})
+}
+
+// ItemCompleted returns the item.completed webhook for item, or nil if the job did not
+// subscribe to them.
+func (n *WebhookNotifier) ItemCompleted(job *entity.Job, item *JobItemResult) (*entity.WebhookDelivery, error) {
+	if !job.Callback.Wants(entity.EventItemCompleted) {
+		return nil, nil
+	}
+	return n.delivery(job, &dto.WebhookPayload{
+		Event: string(entity.EventItemCompleted),
+		JobID: job.ID,
+		Item:  mapJobResultToDTO(item),
+	})
+}
+
+func (n *WebhookNotifier) delivery(job *entity.Job, payload *dto.WebhookPayload) (*entity.WebhookDelivery, error) {
+	now := n.now().UTC()
+	payload.CreatedAt = now
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.WebhookDelivery{
+		ID:            id,
+		JobID:         job.ID,
+		Tenant:        job.Tenant,
+		URL:           job.Callback.URL,
+		Event:         entity.WebhookEvent(payload.Event),
+		Payload:       body,
+		Status:        entity.DeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// DeliverWebhooksUsecase sends due webhooks, retrying failures with exponential backoff.
+type DeliverWebhooksUsecase struct {
+	repo   WebhookRepository
+	sender WebhookSender
+	config WebhookConfig
+	now    func() time.Time
+}
+
+// NewDeliverWebhooksUsecase creates a new DeliverWebhooksUsecase.
+func NewDeliverWebhooksUsecase(repo WebhookRepository, sender WebhookSender, config WebhookConfig) *DeliverWebhooksUsecase {
+	if config.Lease <= 0 {
+		config.Lease = defaultDeliveryLease
+	}
+	return &DeliverWebhooksUsecase{repo: repo, sender: sender, config: config, now: time.Now}
+}
+
+// Execute claims the due deliveries and attempts each once, reporting how many it
+// attempted. Delivery failures are recorded on the delivery; only storage errors are
+// returned. An outcome is dropped if the delivery was redelivered in the meantime.
+func (uc *DeliverWebhooksUsecase) Execute(ctx context.Context) (int, error) {
+	now := uc.now().UTC()
+	due, err := uc.repo.ClaimDueDeliveries(ctx, now, now.Add(uc.config.Lease), dueDeliveriesBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range due {
+		uc.attempt(ctx, delivery)
+		if _, err := uc.repo.UpdateDelivery(ctx, delivery); err != nil {
+			return 0, err
+		}
+	}
+	return len(due), nil
+}
+
+func (uc *DeliverWebhooksUsecase) attempt(ctx context.Context, delivery *entity.WebhookDelivery) {
+	now := uc.now().UTC()
+	delivery.Attempts++
+	delivery.UpdatedAt = now
+
+	secret, ok := uc.config.Secrets[delivery.Tenant]
+	if !ok {
+		delivery.Status = entity.DeliveryDead
+		delivery.LastError = fmt.Sprintf("no webhook secret configured for tenant %q", delivery.Tenant)
+		return
+	}
+
+	headers := map[string]string{
+		HeaderWebhookID:        delivery.ID,
+		HeaderWebhookEvent:     string(delivery.Event),
+		HeaderWebhookSignature: SignWebhook(secret, now, delivery.Payload),
+	}
+
+	err := uc.sender.Send(ctx, delivery.URL, headers, delivery.Payload)
+	if err == nil {
+		delivery.Status = entity.DeliveryDelivered
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= uc.config.MaxAttempts {
+		delivery.Status = entity.DeliveryDead
+		return
+	}
+	delivery.NextAttemptAt = now.Add(uc.backoff(delivery.Attempts))
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (uc *DeliverWebhooksUsecase) backoff(attempts int) time.Duration {
+	wait := uc.config.BaseBackoff
+	for i := 1; i < attempts && wait < uc.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, uc.config.MaxBackoff)
+}
+
+//go:generate mockgen -destination=webhooks_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase WebhooksUsecaseInterface
+type WebhooksUsecaseInterface interface {
+	List(ctx context.Context, jobID string) (*dto.WebhookDeliveriesResponse, error)
+	Redeliver(ctx context.Context, id string) (*dto.WebhookDeliveriesResponse, error)
+}
+
+// WebhooksUsecase lets tenants inspect their webhook deliveries and redeliver them.
+type WebhooksUsecase struct {
+	jobs       JobRepository
+	deliveries WebhookRepository
+	now        func() time.Time
+}
+
+// NewWebhooksUsecase creates a new WebhooksUsecase.
+func NewWebhooksUsecase(jobs JobRepository, deliveries WebhookRepository) *WebhooksUsecase {
+	return &WebhooksUsecase{jobs: jobs, deliveries: deliveries, now: time.Now}
+}
+
+// List returns the deliveries for one of the calling tenant's jobs.
+func (uc *WebhooksUsecase) List(ctx context.Context, jobID string) (*dto.WebhookDeliveriesResponse, error) {
+	job, ok, err := uc.jobs.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || job.Tenant != entity.TenantFromContext(ctx) {
+		return nil, &domainerrors.NotFoundError{Resource: "job", ID: jobID}
+	}
+
+	deliveries, err := uc.deliveries.ListDeliveries(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.WebhookDeliveriesResponse{Success: true, Message: fmt.Sprintf("Found %d deliveries", len(deliveries))}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, mapDeliveryToDTO(delivery))
+	}
+	return resp, nil
+}
+
+// Redeliver queues a delivery to be sent again immediately with a fresh set of attempts,
+// whether it was dead or already delivered.
+func (uc *WebhooksUsecase) Redeliver(ctx context.Context, id string) (*dto.WebhookDeliveriesResponse, error) {
+	delivery, ok, err := uc.deliveries.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || delivery.Tenant != entity.TenantFromContext(ctx) {
+		return nil, &domainerrors.NotFoundError{Resource: "webhook delivery", ID: id}
+	}
+
+	// The reset is one write, so it wins over an attempt in flight rather than racing it.
+	now := uc.now().UTC()
+	if err := uc.deliveries.ResetDelivery(ctx, id, now); err != nil {
+		return nil, err
+	}
+	delivery.Status = entity.DeliveryPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+
+	return &dto.WebhookDeliveriesResponse{
+		Success:    true,
+		Deliveries: []*dto.WebhookDeliveryDTO{mapDeliveryToDTO(delivery)},
+		Message:    "Delivery queued",
+	}, nil
+}
+
+func mapDeliveryToDTO(delivery *entity.WebhookDelivery) *dto.WebhookDeliveryDTO {
+	out := &dto.WebhookDeliveryDTO{
+		ID:        delivery.ID,
+		JobID:     delivery.JobID,
+		Event:     string(delivery.Event),
+		URL:       delivery.URL,
+		Status:    string(delivery.Status),
+		Attempts:  delivery.Attempts,
+		LastError: delivery.LastError,
+		CreatedAt: delivery.CreatedAt,
+		UpdatedAt: delivery.UpdatedAt,
+	}
+	if delivery.Status == entity.DeliveryPending {
+		out.NextAttemptAt = delivery.NextAttemptAt
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/williandandrade/address-validation-service/internal/usecase (interfaces: WebhooksUsecaseInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination=webhooks_mock.go -package=usecase github.com/williandandrade/address-validation-service/internal/usecase WebhooksUsecaseInterface
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/williandandrade/address-validation-service/internal/api/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhooksUsecaseInterface is a mock of WebhooksUsecaseInterface interface.
+type MockWebhooksUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksUsecaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhooksUsecaseInterfaceMockRecorder is the mock recorder for MockWebhooksUsecaseInterface.
+type MockWebhooksUsecaseInterfaceMockRecorder struct {
+	mock *MockWebhooksUsecaseInterface
+}
+
+// NewMockWebhooksUsecaseInterface creates a new mock instance.
+func NewMockWebhooksUsecaseInterface(ctrl *gomock.Controller) *MockWebhooksUsecaseInterface {
+	mock := &MockWebhooksUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhooksUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhooksUsecaseInterface) EXPECT() *MockWebhooksUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockWebhooksUsecaseInterface) List(ctx context.Context, jobID string) (*dto.WebhookDeliveriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, jobID)
+	ret0, _ := ret[0].(*dto.WebhookDeliveriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhooksUsecaseInterfaceMockRecorder) List(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhooksUsecaseInterface)(nil).List), ctx, jobID)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhooksUsecaseInterface) Redeliver(ctx context.Context, id string) (*dto.WebhookDeliveriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, id)
+	ret0, _ := ret[0].(*dto.WebhookDeliveriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhooksUsecaseInterfaceMockRecorder) Redeliver(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhooksUsecaseInterface)(nil).Redeliver), ctx, id)
+}
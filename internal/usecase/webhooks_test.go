@@ -0,0 +1,375 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/domain/entity"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+)
+
+// fakeWebhookRepository keeps deliveries in memory with the same semantics as the SQL store.
+type fakeWebhookRepository struct {
+	mu         sync.Mutex
+	deliveries map[string]*entity.WebhookDelivery
+	claims     map[string]time.Time
+}
+
+func newFakeWebhookRepository() *fakeWebhookRepository {
+	return &fakeWebhookRepository{
+		deliveries: make(map[string]*entity.WebhookDelivery),
+		claims:     make(map[string]time.Time),
+	}
+}
+
+func (r *fakeWebhookRepository) CreateDelivery(_ context.Context, delivery *entity.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *delivery
+	r.deliveries[delivery.ID] = &stored
+	return nil
+}
+
+func (r *fakeWebhookRepository) GetDelivery(_ context.Context, id string) (*entity.WebhookDelivery, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, false, nil
+	}
+	out := *delivery
+	return &out, true, nil
+}
+
+func (r *fakeWebhookRepository) ListDeliveries(_ context.Context, jobID string) ([]*entity.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []*entity.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.JobID == jobID {
+			copied := *delivery
+			out = append(out, &copied)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (r *fakeWebhookRepository) ClaimDueDeliveries(_ context.Context, now, until time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []*entity.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		due := delivery.Status == entity.DeliveryPending && !delivery.NextAttemptAt.After(now)
+		if due && !r.claims[delivery.ID].After(now) && len(out) < limit {
+			r.claims[delivery.ID] = until
+			delivery.Version++
+			copied := *delivery
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeWebhookRepository) UpdateDelivery(_ context.Context, delivery *entity.WebhookDelivery) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.deliveries[delivery.ID].Version != delivery.Version {
+		return false, nil
+	}
+	stored := *delivery
+	stored.Version++
+	r.deliveries[delivery.ID] = &stored
+	delete(r.claims, delivery.ID)
+	return true, nil
+}
+
+func (r *fakeWebhookRepository) ResetDelivery(_ context.Context, id string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery := r.deliveries[id]
+	delivery.Status = entity.DeliveryPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+	delivery.Version++
+	delete(r.claims, id)
+	return nil
+}
+
+func (r *fakeWebhookRepository) only(t *testing.T) *entity.WebhookDelivery {
+	t.Helper()
+	require.Len(t, r.deliveries, 1)
+	for _, delivery := range r.deliveries {
+		return delivery
+	}
+	return nil
+}
+
+type sentWebhook struct {
+	url     string
+	headers map[string]string
+	body    []byte
+}
+
+// fakeSender records webhooks and fails while err is set. during, if set, runs while a
+// webhook is being sent.
+type fakeSender struct {
+	sent   []sentWebhook
+	err    error
+	during func()
+}
+
+func (s *fakeSender) Send(_ context.Context, url string, headers map[string]string, body []byte) error {
+	s.sent = append(s.sent, sentWebhook{url: url, headers: headers, body: body})
+	if s.during != nil {
+		s.during()
+	}
+	return s.err
+}
+
+func TestSignWebhook(t *testing.T) {
+	ts := time.Unix(1700000000, 0)
+
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"t=1700000000,v1=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163",
+		SignWebhook("secret", ts, []byte("{}")))
+}
+
+func TestRunJobsUsecase_Webhooks(t *testing.T) {
+	ctx := entity.ContextWithTenant(context.Background(), "acme")
+	repo := newFakeJobRepository()
+
+	jobs := NewJobsUsecase(repo, JobsConfig{WebhookSecrets: map[string]string{"acme": "s3cret"}})
+	jobs.lookup = publicLookup
+	submitted, err := jobs.Submit(ctx, &dto.CreateJobRequest{
+		Addresses:      []string{"123 Main St, Springfield, IL 62701", ""},
+		CallbackURL:    "https://example.com/hooks",
+		CallbackEvents: []string{"job.completed", "item.completed"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hooks", submitted.Job.CallbackURL)
+
+	validator := NewValidateAddressUsecase(&countingRepo{}, nil, ValidateAddressConfig{})
+	_, err = NewRunJobsUsecase(repo, validator, NewWebhookNotifier(), RunJobsConfig{}).Execute(ctx)
+	require.NoError(t, err)
+
+	deliveries := repo.notified
+	require.Len(t, deliveries, 3, "each webhook is stored with the result it reports")
+
+	events := map[entity.WebhookEvent]int{}
+	for _, delivery := range deliveries {
+		events[delivery.Event]++
+		assert.Equal(t, "acme", delivery.Tenant)
+		assert.Equal(t, "https://example.com/hooks", delivery.URL)
+		assert.Equal(t, entity.DeliveryPending, delivery.Status)
+
+		if delivery.Event == entity.EventJobCompleted {
+			var payload dto.WebhookPayload
+			require.NoError(t, json.Unmarshal(delivery.Payload, &payload))
+			assert.Equal(t, "done", payload.Job.Status)
+			assert.Equal(t, 2, payload.Job.Processed, "the payload carries the final progress")
+		}
+	}
+	assert.Equal(t, map[entity.WebhookEvent]int{entity.EventJobCompleted: 1, entity.EventItemCompleted: 2}, events)
+}
+
+func TestDeliverWebhooksUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	config := WebhookConfig{
+		Secrets:     map[string]string{"acme": "s3cret"},
+		MaxAttempts: 3,
+		BaseBackoff: time.Second,
+		MaxBackoff:  90 * time.Second,
+	}
+
+	newDelivery := func(tenant string) *entity.WebhookDelivery {
+		return &entity.WebhookDelivery{
+			ID: "d1", JobID: "job", Tenant: tenant, URL: "https://example.com/hooks",
+			Event: entity.EventJobCompleted, Payload: []byte(`{"event":"job.completed"}`),
+			Status: entity.DeliveryPending, NextAttemptAt: start,
+		}
+	}
+
+	t.Run("success is signed and marked delivered", func(t *testing.T) {
+		repo := newFakeWebhookRepository()
+		require.NoError(t, repo.CreateDelivery(ctx, newDelivery("acme")))
+		sender := &fakeSender{}
+
+		uc := NewDeliverWebhooksUsecase(repo, sender, config)
+		uc.now = func() time.Time { return start }
+
+		n, err := uc.Execute(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, "d1", sender.sent[0].headers[HeaderWebhookID])
+		assert.Equal(t, "job.completed", sender.sent[0].headers[HeaderWebhookEvent])
+		assert.Equal(t, SignWebhook("s3cret", start, sender.sent[0].body), sender.sent[0].headers[HeaderWebhookSignature])
+
+		delivery := repo.only(t)
+		assert.Equal(t, entity.DeliveryDelivered, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+	})
+
+	t.Run("failures back off exponentially then go dead", func(t *testing.T) {
+		repo := newFakeWebhookRepository()
+		require.NoError(t, repo.CreateDelivery(ctx, newDelivery("acme")))
+		sender := &fakeSender{err: errors.New("503 Service Unavailable")}
+
+		now := start
+		uc := NewDeliverWebhooksUsecase(repo, sender, config)
+		uc.now = func() time.Time { return now }
+
+		for _, wait := range []time.Duration{time.Second, 2 * time.Second} {
+			_, err := uc.Execute(ctx)
+			require.NoError(t, err)
+
+			delivery := repo.only(t)
+			assert.Equal(t, entity.DeliveryPending, delivery.Status)
+			assert.Equal(t, now.Add(wait), delivery.NextAttemptAt)
+
+			n, _ := uc.Execute(ctx)
+			assert.Zero(t, n, "not due before the backoff elapses")
+			now = delivery.NextAttemptAt
+		}
+
+		_, err := uc.Execute(ctx)
+		require.NoError(t, err)
+
+		delivery := repo.only(t)
+		assert.Equal(t, entity.DeliveryDead, delivery.Status)
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Equal(t, "503 Service Unavailable", delivery.LastError)
+	})
+
+	t.Run("claimed deliveries are not attempted twice", func(t *testing.T) {
+		repo := newFakeWebhookRepository()
+		require.NoError(t, repo.CreateDelivery(ctx, newDelivery("acme")))
+
+		due, err := repo.ClaimDueDeliveries(ctx, start, start.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		sender := &fakeSender{}
+		uc := NewDeliverWebhooksUsecase(repo, sender, config)
+		uc.now = func() time.Time { return start }
+
+		n, err := uc.Execute(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n, "another deliverer holds the claim")
+		assert.Empty(t, sender.sent)
+
+		uc.now = func() time.Time { return start.Add(time.Minute) }
+		n, err = uc.Execute(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n, "the delivery is retried once the claim runs out")
+	})
+
+	t.Run("a redelivery during an attempt is kept", func(t *testing.T) {
+		repo := newFakeWebhookRepository()
+		last := newDelivery("acme")
+		last.Attempts = config.MaxAttempts - 1
+		require.NoError(t, repo.CreateDelivery(ctx, last))
+
+		sender := &fakeSender{err: errors.New("503 Service Unavailable")}
+		sender.during = func() { require.NoError(t, repo.ResetDelivery(ctx, "d1", start)) }
+		uc := NewDeliverWebhooksUsecase(repo, sender, config)
+		uc.now = func() time.Time { return start }
+
+		_, err := uc.Execute(ctx)
+		require.NoError(t, err)
+
+		delivery := repo.only(t)
+		assert.Equal(t, entity.DeliveryPending, delivery.Status, "the failed attempt does not overwrite the redelivery")
+		assert.Zero(t, delivery.Attempts)
+	})
+
+	t.Run("tenant without a secret is dead without sending", func(t *testing.T) {
+		repo := newFakeWebhookRepository()
+		require.NoError(t, repo.CreateDelivery(ctx, newDelivery("other")))
+		sender := &fakeSender{}
+
+		uc := NewDeliverWebhooksUsecase(repo, sender, config)
+		uc.now = func() time.Time { return start }
+
+		_, err := uc.Execute(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, sender.sent)
+		assert.Equal(t, entity.DeliveryDead, repo.only(t).Status)
+	})
+}
+
+func TestDeliverWebhooksUsecase_Backoff(t *testing.T) {
+	uc := NewDeliverWebhooksUsecase(nil, nil, WebhookConfig{BaseBackoff: 5 * time.Second, MaxBackoff: time.Minute})
+
+	assert.Equal(t, 5*time.Second, uc.backoff(1))
+	assert.Equal(t, 10*time.Second, uc.backoff(2))
+	assert.Equal(t, 40*time.Second, uc.backoff(4))
+	assert.Equal(t, time.Minute, uc.backoff(5))
+	assert.Equal(t, time.Minute, uc.backoff(50))
+}
+
+func TestWebhooksUsecase(t *testing.T) {
+	acme := entity.ContextWithTenant(context.Background(), "acme")
+	other := entity.ContextWithTenant(context.Background(), "other")
+
+	jobs := newFakeJobRepository()
+	require.NoError(t, jobs.CreateJob(acme, &entity.Job{ID: "job", Tenant: "acme", Status: entity.JobDone}, &dto.ValidateRequest{}, []string{"a"}))
+
+	webhooks := newFakeWebhookRepository()
+	require.NoError(t, webhooks.CreateDelivery(acme, &entity.WebhookDelivery{
+		ID: "d1", JobID: "job", Tenant: "acme", Event: entity.EventJobCompleted,
+		Status: entity.DeliveryDead, Attempts: 8, LastError: "timeout",
+	}))
+
+	uc := NewWebhooksUsecase(jobs, webhooks)
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := uc.List(acme, "job")
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		assert.Equal(t, "dead", resp.Deliveries[0].Status)
+		assert.Equal(t, "timeout", resp.Deliveries[0].LastError)
+	})
+
+	t.Run("redeliver resets the delivery", func(t *testing.T) {
+		resp, err := uc.Redeliver(acme, "d1")
+		require.NoError(t, err)
+		assert.Equal(t, "pending", resp.Deliveries[0].Status)
+
+		delivery := webhooks.only(t)
+		assert.Equal(t, entity.DeliveryPending, delivery.Status)
+		assert.Zero(t, delivery.Attempts)
+		assert.Empty(t, delivery.LastError)
+	})
+
+	t.Run("other tenants cannot see or redeliver", func(t *testing.T) {
+		var nf *domainerrors.NotFoundError
+
+		_, err := uc.List(other, "job")
+		require.ErrorAs(t, err, &nf)
+
+		_, err = uc.Redeliver(other, "d1")
+		require.ErrorAs(t, err, &nf)
+	})
+}
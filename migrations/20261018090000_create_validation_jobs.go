@@ -0,0 +1,45 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Timestamps are Unix milliseconds. Job progress is counted from the items, so it
+// cannot drift from the stored results.
+const (
+	createValidationJobsTable = `CREATE TABLE IF NOT EXISTS validation_jobs (
+		id         TEXT PRIMARY KEY,
+		status     TEXT NOT NULL,
+		error      TEXT NOT NULL DEFAULT '',
+		options    TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`
+
+	createValidationJobsStatusIndex = `CREATE INDEX IF NOT EXISTS idx_validation_jobs_status
+		ON validation_jobs (status, created_at)`
+
+	createValidationJobItemsTable = `CREATE TABLE IF NOT EXISTS validation_job_items (
+		job_id   TEXT NOT NULL,
+		position INTEGER NOT NULL,
+		address  TEXT NOT NULL,
+		status   TEXT NOT NULL,
+		result   TEXT,
+		PRIMARY KEY (job_id, position)
+	)`
+)
+
+func createValidationJobs() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, stmt := range []string{
+				createValidationJobsTable,
+				createValidationJobsStatusIndex,
+				createValidationJobItemsTable,
+			} {
+				if _, err := d.SQL.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Jobs from before tenants belong to the default tenant. Callback events are stored
+// comma-separated; an empty callback_url means the job has no callback.
+const (
+	addValidationJobsTenant         = `ALTER TABLE validation_jobs ADD COLUMN tenant TEXT NOT NULL DEFAULT 'default'`
+	addValidationJobsCallbackURL    = `ALTER TABLE validation_jobs ADD COLUMN callback_url TEXT NOT NULL DEFAULT ''`
+	addValidationJobsCallbackEvents = `ALTER TABLE validation_jobs ADD COLUMN callback_events TEXT NOT NULL DEFAULT ''`
+
+	createWebhookDeliveriesTable = `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id              TEXT PRIMARY KEY,
+		job_id          TEXT NOT NULL,
+		tenant          TEXT NOT NULL,
+		url             TEXT NOT NULL,
+		event           TEXT NOT NULL,
+		payload         TEXT NOT NULL,
+		status          TEXT NOT NULL,
+		attempts        INTEGER NOT NULL DEFAULT 0,
+		last_error      TEXT NOT NULL DEFAULT '',
+		next_attempt_at INTEGER NOT NULL,
+		created_at      INTEGER NOT NULL,
+		updated_at      INTEGER NOT NULL
+	)`
+
+	createWebhookDeliveriesDueIndex = `CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+		ON webhook_deliveries (status, next_attempt_at)`
+
+	createWebhookDeliveriesJobIndex = `CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_job
+		ON webhook_deliveries (job_id, created_at)`
+)
+
+func addJobWebhooks() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, stmt := range []string{
+				addValidationJobsTenant,
+				addValidationJobsCallbackURL,
+				addValidationJobsCallbackEvents,
+				createWebhookDeliveriesTable,
+				createWebhookDeliveriesDueIndex,
+				createWebhookDeliveriesJobIndex,
+			} {
+				if _, err := d.SQL.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// A deliverer claims a due delivery by setting claimed_until, its lease; other
+// deliverers skip it until the lease runs out. version counts changes to a delivery,
+// so an attempt only stores its outcome if nothing, such as a redelivery, came first.
+const (
+	addWebhookDeliveriesClaimedUntil = `ALTER TABLE webhook_deliveries ADD COLUMN claimed_until INTEGER NOT NULL DEFAULT 0`
+	addWebhookDeliveriesVersion      = `ALTER TABLE webhook_deliveries ADD COLUMN version INTEGER NOT NULL DEFAULT 0`
+)
+
+func addWebhookDeliveryClaims() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, stmt := range []string{
+				addWebhookDeliveriesClaimedUntil,
+				addWebhookDeliveriesVersion,
+			} {
+				if _, err := d.SQL.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
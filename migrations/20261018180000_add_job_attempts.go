@@ -0,0 +1,26 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// attempts counts the runs of a job that stopped without finishing; next_attempt_at,
+// in Unix milliseconds, is when a requeued job may be claimed again.
+const (
+	addValidationJobsAttempts      = `ALTER TABLE validation_jobs ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0`
+	addValidationJobsNextAttemptAt = `ALTER TABLE validation_jobs ADD COLUMN next_attempt_at INTEGER NOT NULL DEFAULT 0`
+)
+
+func addJobAttempts() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, stmt := range []string{
+				addValidationJobsAttempts,
+				addValidationJobsNextAttemptAt,
+			} {
+				if _, err := d.SQL.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
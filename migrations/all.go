@@ -0,0 +1,14 @@
+// Package migrations holds the GoFr SQL migrations, applied in key order at startup.
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// All returns every migration keyed by its creation timestamp.
+func All() map[int64]migration.Migrate {
+	return map[int64]migration.Migrate{
+		20261018090000: createValidationJobs(),
+		20261018120000: addJobWebhooks(),
+		20261018150000: addWebhookDeliveryClaims(),
+		20261018180000: addJobAttempts(),
+	}
+}
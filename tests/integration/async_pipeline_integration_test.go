@@ -0,0 +1,46 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	domainerrors "github.com/williandandrade/address-validation-service/internal/domain/errors"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/cache"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/queue"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func newTestAsyncUsecase() *usecase.AsyncValidateAddressUsecase {
+	results := cache.NewResultStore(cache.NewMemoryStore(), time.Minute)
+	jobs := queue.NewMemoryQueue(16, queue.MemoryQueueConfig{})
+	jobs.Start(2, usecase.NewProcessValidationJobUsecase(newTestUsecase(), results).Execute)
+
+	return usecase.NewAsyncValidateAddressUsecase(jobs, results, usecase.AsyncValidateAddressConfig{
+		WaitTimeout: 5 * time.Second,
+	})
+}
+
+func TestIntegration_AsyncPipeline(t *testing.T) {
+	uc := newTestAsyncUsecase()
+	ctx := context.Background()
+
+	resp, err := uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St, Springfield, IL 62701"})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, "Springfield", resp.Address.City)
+	assert.Equal(t, "IL", resp.Address.State)
+
+	_, err = uc.Execute(ctx, &dto.ValidateRequest{Address: "83702"})
+	var pe *domainerrors.ParsingError
+	require.ErrorAs(t, err, &pe, "worker errors keep their domain type")
+
+	_, err = uc.Execute(ctx, &dto.ValidateRequest{Address: "123 Main St", Mode: "bogus"})
+	var ve *domainerrors.ValidationError
+	require.ErrorAs(t, err, &ve, "invalid options fail before publishing")
+	assert.Equal(t, "mode", ve.Field)
+}
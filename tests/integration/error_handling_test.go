@@ -62,4 +62,25 @@ func TestIntegration_CorrectionsTracking(t *testing.T) {
 	assert.True(t, resp.Success)
 	// Lowercase input should trigger capitalization correction
 	assert.NotEmpty(t, resp.CorrectionsApplied)
+
+	t.Run("all-caps input is valid as written", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{
+			Address: "123 MAIN ST, SPRINGFIELD, IL 62701",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.StatusValid, resp.Status)
+		assert.Empty(t, resp.CorrectionsApplied)
+		assert.Empty(t, resp.Corrections)
+	})
+
+	t.Run("mixed-case words are not reported as re-cased", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{
+			Address: "PO Box 12, McDonald, PA 15057",
+		})
+
+		require.NoError(t, err)
+		assert.NotContains(t, resp.CorrectionsApplied, "Standardized capitalization")
+		assert.Empty(t, resp.Corrections)
+	})
 }
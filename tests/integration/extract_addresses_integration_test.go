@@ -0,0 +1,38 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/williandandrade/address-validation-service/internal/api/dto"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/usecase"
+)
+
+func TestIntegration_ExtractAddresses(t *testing.T) {
+	uc := usecase.NewExtractAddressesUsecase(address_parser.NewSpanFinder(), newTestUsecase())
+
+	text := "Hi team, the customer moved from 123 main st, springfield, IL 62701.\n" +
+		"New delivery address:\n456 Oak Ave\nLos Angeles, CA 90210\nThanks!"
+
+	resp, err := uc.Execute(context.Background(), &dto.ExtractRequest{Text: text})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Addresses, 2)
+
+	first := resp.Addresses[0]
+	assert.Equal(t, "123 main st, springfield, IL 62701", first.Text)
+	assert.Equal(t, first.Text, text[first.Start:first.End])
+	require.True(t, first.Result.Success)
+	assert.Equal(t, "Springfield", first.Result.Address.City)
+	assert.Equal(t, "62701", first.Result.Address.PostalCode)
+	assert.Greater(t, first.Confidence, 0.9)
+
+	second := resp.Addresses[1]
+	require.True(t, second.Result.Success)
+	assert.Equal(t, "CA", second.Result.Address.State)
+	assert.Equal(t, "90210", second.Result.Address.PostalCode)
+}
@@ -9,12 +9,17 @@ import (
 
 	"github.com/williandandrade/address-validation-service/internal/api/dto"
 	"github.com/williandandrade/address-validation-service/internal/infrastructure/address_parser"
+	"github.com/williandandrade/address-validation-service/internal/infrastructure/reference"
 	"github.com/williandandrade/address-validation-service/internal/usecase"
 )
 
 func newTestUsecase() *usecase.ValidateAddressUsecase {
 	parser := address_parser.NewGopostalParser()
-	return usecase.NewValidateAddressUsecase(parser)
+	localities, err := reference.NewLocalityStore()
+	if err != nil {
+		panic(err)
+	}
+	return usecase.NewValidateAddressUsecase(parser, localities, usecase.ValidateAddressConfig{})
 }
 
 func TestIntegration_ValidAddress(t *testing.T) {
@@ -139,3 +144,68 @@ func TestIntegration_POBox(t *testing.T) {
 	assert.True(t, resp.Success)
 	assert.Equal(t, "po_box", resp.Address.AddressType)
 }
+
+func TestIntegration_ComponentOffsets(t *testing.T) {
+	uc := newTestUsecase()
+
+	input := "123 main st, springfield, il 62701"
+	resp, err := uc.Execute(context.Background(), &dto.ValidateRequest{Address: input, IncludeComponents: true})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Components)
+
+	for name, comp := range resp.Components {
+		assert.Equal(t, comp.Raw, input[comp.Start:comp.End], name)
+	}
+	assert.Equal(t, "Springfield", resp.Components["city"].Value)
+	assert.Equal(t, "springfield", resp.Components["city"].Raw)
+	assert.Equal(t, "IL", resp.Components["state"].Value)
+}
+
+func TestIntegration_PartialModes(t *testing.T) {
+	uc := newTestUsecase()
+
+	tests := []struct {
+		name           string
+		input          *dto.ValidateRequest
+		expectedStreet string
+		expectedCity   string
+		expectedState  string
+	}{
+		{
+			name:          "bare ZIP in postal mode",
+			input:         &dto.ValidateRequest{Address: "10001", Mode: "postal"},
+			expectedCity:  "New York",
+			expectedState: "NY",
+		},
+		{
+			name:           "street and ZIP in postal mode",
+			input:          &dto.ValidateRequest{Address: "123 Main St 83702", Mode: "postal"},
+			expectedStreet: "123 Main St",
+			expectedCity:   "Boise",
+			expectedState:  "ID",
+		},
+		{
+			name:          "city and state in locality mode",
+			input:         &dto.ValidateRequest{Address: "Boise, ID", Mode: "locality"},
+			expectedCity:  "Boise",
+			expectedState: "ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := uc.Execute(context.Background(), tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStreet, resp.Address.StreetAddress)
+			assert.Equal(t, tt.expectedCity, resp.Address.City)
+			assert.Equal(t, tt.expectedState, resp.Address.State)
+		})
+	}
+
+	t.Run("bare ZIP rejected in full mode", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), &dto.ValidateRequest{Address: "10001"})
+		require.Error(t, err)
+	})
+}